@@ -0,0 +1,9 @@
+action "graylog_index_ranges_rebuild" "web" {
+  config {
+    index_set_id = graylog_index_set.web.id
+  }
+}
+
+# Rebuild the ranges of every index, e.g. after restoring a snapshot:
+#   terraform apply -invoke=action.graylog_index_ranges_rebuild.all
+action "graylog_index_ranges_rebuild" "all" {}
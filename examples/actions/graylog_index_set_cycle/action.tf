@@ -0,0 +1,21 @@
+action "graylog_index_set_cycle" "web" {
+  config {
+    index_set_id = graylog_index_set.web.id
+  }
+}
+
+# Rotate the write index whenever the field types change, so they apply
+# right away. Run it on demand with:
+#   terraform apply -invoke=action.graylog_index_set_cycle.web
+resource "graylog_index_set_field_mapping" "http_status" {
+  index_set_id = graylog_index_set.web.id
+  field        = "http_status"
+  type         = "int"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.graylog_index_set_cycle.web]
+    }
+  }
+}
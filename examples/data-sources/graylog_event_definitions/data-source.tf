@@ -0,0 +1,9 @@
+data "graylog_event_definitions" "high_priority" {
+  title_regex = "^(?i)prod"
+  priority    = 3
+  type        = "aggregation-v1"
+}
+
+output "high_priority_definitions" {
+  value = { for definition in data.graylog_event_definitions.high_priority.event_definitions : definition.id => definition.title }
+}
@@ -0,0 +1,16 @@
+data "graylog_event_notifications" "pagerduty" {
+  type = "pagerduty-notification-v2"
+}
+
+resource "graylog_event_definition" "disk_full" {
+  title            = "Disk Full"
+  priority         = 3
+  config_type      = "aggregation-v1"
+  notification_ids = data.graylog_event_notifications.pagerduty.ids
+
+  aggregation {
+    query            = "disk_usage:>95"
+    search_within_ms = 300000
+    execute_every_ms = 60000
+  }
+}
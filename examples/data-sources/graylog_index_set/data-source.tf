@@ -0,0 +1,12 @@
+data "graylog_index_set" "default" {
+  default = true
+}
+
+data "graylog_index_set" "audit" {
+  index_prefix = "audit"
+}
+
+resource "graylog_stream" "audit" {
+  title        = "Audit Events"
+  index_set_id = data.graylog_index_set.audit.id
+}
@@ -0,0 +1,9 @@
+data "graylog_index_sets" "all" {}
+
+output "index_set_sizes" {
+  value = { for index_set in data.graylog_index_sets.all.index_sets : index_set.title => index_set.size_bytes }
+}
+
+output "total_documents" {
+  value = data.graylog_index_sets.all.document_count
+}
@@ -0,0 +1,4 @@
+data "graylog_input" "syslog" {
+  title = "Syslog UDP Input"
+  type  = "org.graylog2.inputs.syslog.udp.SyslogUDPInput"
+}
@@ -0,0 +1,10 @@
+data "graylog_input_types" "gelf_udp" {
+  type = "org.graylog2.inputs.gelf.udp.GELFUDPInput"
+}
+
+output "gelf_udp_required_attributes" {
+  value = [
+    for key, field in data.graylog_input_types.gelf_udp.types[0].requested_configuration : key
+    if !field.is_optional
+  ]
+}
@@ -0,0 +1,8 @@
+data "graylog_inputs" "global_syslog" {
+  type   = "org.graylog2.inputs.syslog.udp.SyslogUDPInput"
+  global = true
+}
+
+output "syslog_ports" {
+  value = { for input in data.graylog_inputs.global_syslog.inputs : input.title => input.attributes["port"] }
+}
@@ -0,0 +1,14 @@
+locals {
+  rule_source = <<-EOT
+    rule "tag payments"
+    when
+      has_field("application")
+    then
+      set_field("team", "billing");
+    end
+  EOT
+}
+
+output "rule_errors" {
+  value = provider::graylog::pipeline_rule_validate(local.rule_source)
+}
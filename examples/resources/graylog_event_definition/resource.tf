@@ -4,13 +4,68 @@ resource "graylog_event_definition" "example" {
   priority    = 2
   config_type = "aggregation-v1"
 
-  config = {
+  aggregation {
     query            = "cpu_usage:>80"
-    search_within_ms = "300000"
-    execute_every_ms = "60000"
-    event_limit      = "100"
+    group_by         = ["source"]
+    search_within_ms = 300000
+    execute_every_ms = 60000
+
+    series {
+      id       = "avg-cpu"
+      function = "avg"
+      field    = "cpu_usage"
+    }
+
+    series {
+      id       = "count"
+      function = "count"
+    }
+
+    conditions {
+      operator = "&&"
+
+      expression {
+        series_id  = "avg-cpu"
+        comparator = ">"
+        value      = 90
+      }
+
+      expression {
+        series_id  = "count"
+        comparator = ">="
+        value      = 5
+      }
+    }
+  }
+
+  field {
+    name = "hostname"
+
+    provider {
+      type     = "template"
+      template = "$${source.source}"
+    }
+  }
+
+  field {
+    name = "owner"
+
+    provider {
+      type       = "lookup_table"
+      table_name = "host-owners"
+      key_field  = "source"
+    }
   }
 
+  key_spec = ["hostname"]
+
+  # Create alerts even though no notification is attached yet.
+  alert = true
+
+  storage = [{
+    streams = ["000000000000000000000002"] # All events
+  }]
+
   grace_period_ms = 300000
   backlog_size    = 500
 }
@@ -1,11 +1,46 @@
-resource "graylog_event_notification" "example" {
-  title             = "Slack Alerts"
-  description       = "Send alerts to Slack channel"
-  notification_type = "slack-notification-v1"
+resource "graylog_event_notification" "slack" {
+  title       = "Slack Alerts"
+  description = "Send alerts to Slack channel"
 
-  config = {
+  slack {
     webhook_url = "https://hooks.slack.com/services/YOUR/WEBHOOK/URL"
     channel     = "#alerts"
     user_name   = "Graylog"
   }
 }
+
+resource "graylog_event_notification" "email" {
+  title = "On-call Email"
+
+  email {
+    subject         = "Graylog event: $${event_definition_title}"
+    body_template   = "$${event.message}"
+    recipients      = ["oncall@example.com"]
+    user_recipients = ["admin"]
+  }
+}
+
+variable "webhook_basic_auth" {
+  type      = string
+  sensitive = true
+}
+
+resource "graylog_event_notification" "webhook" {
+  title = "Incident Webhook"
+
+  http {
+    url           = "https://hooks.example.com/graylog"
+    method        = "POST"
+    body_template = "{\"title\": \"$${event_definition_title}\"}"
+
+    headers = {
+      "X-Source" = "graylog"
+    }
+  }
+
+  # Never stored in state; bump the version to send new credentials.
+  config_wo = {
+    basic_auth = var.webhook_basic_auth
+  }
+  config_wo_version = 1
+}
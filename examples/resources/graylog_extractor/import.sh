@@ -0,0 +1,2 @@
+# Extractors are imported using the input ID and the extractor ID
+terraform import graylog_extractor.http_status 5f1a2b3c4d5e6f7a8b9c0d1e/5f1a2b3c4d5e6f7a8b9c0d2f
@@ -0,0 +1,54 @@
+resource "graylog_extractor" "http_status" {
+  input_id     = graylog_input.example.id
+  title        = "HTTP status"
+  type         = "regex"
+  source_field = "message"
+  target_field = "http_status"
+  order        = 0
+
+  config = {
+    regex_value = "HTTP/1\\.[01]\" (\\d{3})"
+  }
+
+  condition_type  = "string"
+  condition_value = "HTTP/1."
+
+  converter {
+    type = "numeric"
+  }
+}
+
+resource "graylog_extractor" "timestamp" {
+  input_id     = graylog_input.example.id
+  title        = "Request time"
+  type         = "split_and_index"
+  source_field = "message"
+  target_field = "request_time"
+  order        = 1
+
+  config = {
+    split_by = " "
+    index    = 4
+  }
+
+  converter {
+    type = "date"
+    config = {
+      date_format = "dd/MMM/yyyy:HH:mm:ss"
+      time_zone   = "UTC"
+    }
+  }
+}
+
+resource "graylog_extractor" "payload" {
+  input_id     = graylog_input.example.id
+  title        = "JSON payload"
+  type         = "json"
+  source_field = "payload"
+  order        = 2
+
+  config = {
+    flatten       = true
+    key_separator = "_"
+  }
+}
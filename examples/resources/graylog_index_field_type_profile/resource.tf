@@ -0,0 +1,20 @@
+resource "graylog_index_field_type_profile" "web" {
+  name        = "Web Access Logs"
+  description = "Field types of web server access logs"
+
+  custom_field_mapping {
+    field = "http_status"
+    type  = "int"
+  }
+
+  custom_field_mapping {
+    field = "client_ip"
+    type  = "ip"
+  }
+}
+
+resource "graylog_index_set" "web" {
+  title              = "Web Access Logs"
+  index_prefix       = "web-access"
+  field_type_profile = graylog_index_field_type_profile.web.id
+}
@@ -10,3 +10,31 @@ resource "graylog_index_set" "example" {
   index_optimization_max_num_segments = 1
   field_type_refresh_interval         = 5000
 }
+
+resource "graylog_index_set" "audit" {
+  title        = "Audit Logs"
+  index_prefix = "audit"
+
+  rotation {
+    time {
+      rotation_period = "P1D"
+    }
+  }
+
+  retention {
+    closing {
+      max_number_of_indices = 90
+    }
+  }
+}
+
+resource "graylog_index_set" "metrics" {
+  title        = "Metrics"
+  index_prefix = "metrics"
+
+  data_tiering {
+    type               = "hot_only"
+    index_lifetime_min = "P7D"
+    index_lifetime_max = "P14D"
+  }
+}
@@ -0,0 +1,2 @@
+# Field mappings are imported using the index set ID and the field name
+terraform import graylog_index_set_field_mapping.response_time 5f1a2b3c4d5e6f7a8b9c0d1e/response_time_ms
@@ -0,0 +1,6 @@
+resource "graylog_index_set_field_mapping" "response_time" {
+  index_set_id       = "5f1a2b3c4d5e6f7a8b9c0d1e"
+  field              = "response_time_ms"
+  type               = "long"
+  rotate_immediately = true
+}
@@ -9,4 +9,47 @@ resource "graylog_input" "example" {
     recv_buffer_size      = 262144
     number_worker_threads = 2
   }
+
+  # Bounds the wait for the input to be running on every node.
+  timeouts {
+    create = "10m"
+    update = "10m"
+  }
+}
+
+variable "tls_key_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "graylog_input" "beats" {
+  title  = "Beats TLS Input"
+  type   = "org.graylog.plugins.beats.Beats2Input"
+  global = true
+
+  attributes = {
+    bind_address  = "0.0.0.0"
+    port          = 5044
+    tls_enable    = true
+    tls_cert_file = "/etc/graylog/server/beats.crt"
+    tls_key_file  = "/etc/graylog/server/beats.key"
+  }
+
+  # Never stored in state; bump the version to send a new password.
+  attributes_wo = {
+    tls_key_password = var.tls_key_password
+  }
+  attributes_wo_version = 1
+}
+
+resource "graylog_input" "gelf_standby" {
+  title         = "GELF UDP Standby Input"
+  type          = "org.graylog2.inputs.gelf.udp.GELFUDPInput"
+  global        = true
+  desired_state = "stopped"
+
+  attributes = {
+    bind_address = "0.0.0.0"
+    port         = 12202
+  }
 }
@@ -0,0 +1,2 @@
+# Static fields are imported using the input ID and the field key
+terraform import graylog_input_static_field.environment 5f1a2b3c4d5e6f7a8b9c0d1e/environment
@@ -0,0 +1,5 @@
+resource "graylog_input_static_field" "environment" {
+  input_id = graylog_input.example.id
+  key      = "environment"
+  value    = "production"
+}
@@ -0,0 +1,9 @@
+resource "graylog_pipeline" "example" {
+  description = "Enrichment of application messages"
+  source      = <<-EOT
+    pipeline "application enrichment"
+    stage 0 match either
+      rule "tag payments";
+    end
+  EOT
+}
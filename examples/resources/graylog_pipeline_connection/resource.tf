@@ -0,0 +1,4 @@
+resource "graylog_pipeline_connection" "example" {
+  stream_id    = graylog_stream.example.id
+  pipeline_ids = [graylog_pipeline.example.id]
+}
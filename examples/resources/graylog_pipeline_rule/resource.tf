@@ -0,0 +1,11 @@
+resource "graylog_pipeline_rule" "example" {
+  description = "Tag messages coming from the payments service"
+  source      = <<-EOT
+    rule "tag payments"
+    when
+      has_field("application") && to_string($message.application) == "payments"
+    then
+      set_field("team", "billing");
+    end
+  EOT
+}
@@ -0,0 +1,19 @@
+resource "graylog_stream" "example" {
+  title                              = "Application Errors"
+  description                        = "Error messages from application servers"
+  index_set_id                       = graylog_index_set.example.id
+  matching_type                      = "AND"
+  remove_matches_from_default_stream = true
+
+  rule {
+    field = "source"
+    type  = "regex"
+    value = "^app-server-.*"
+  }
+
+  rule {
+    field = "level"
+    type  = "smaller"
+    value = "4"
+  }
+}
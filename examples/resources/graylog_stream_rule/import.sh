@@ -0,0 +1,2 @@
+# Stream rules are imported using the stream ID and the rule ID
+terraform import graylog_stream_rule.example 5f1a2b3c4d5e6f7a8b9c0d1e/5f1a2b3c4d5e6f7a8b9c0d2f
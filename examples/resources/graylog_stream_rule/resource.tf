@@ -0,0 +1,7 @@
+resource "graylog_stream_rule" "example" {
+  stream_id   = "5f1a2b3c4d5e6f7a8b9c0d1e"
+  field       = "application"
+  type        = "exact"
+  value       = "payments"
+  description = "Route payment service messages"
+}
@@ -0,0 +1,115 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &indexRangesRebuildAction{}
+	_ action.ActionWithConfigure = &indexRangesRebuildAction{}
+)
+
+// NewIndexRangesRebuildAction is a helper function to simplify the provider implementation.
+func NewIndexRangesRebuildAction() action.Action {
+	return &indexRangesRebuildAction{}
+}
+
+// indexRangesRebuildAction is the action implementation.
+type indexRangesRebuildAction struct {
+	client *client.Client
+}
+
+// indexRangesRebuildActionModel maps the action schema data.
+type indexRangesRebuildActionModel struct {
+	IndexSetID types.String `tfsdk:"index_set_id"`
+	Index      types.String `tfsdk:"index"`
+}
+
+// Metadata returns the action type name.
+func (a *indexRangesRebuildAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_ranges_rebuild"
+}
+
+// Schema defines the schema for the action.
+func (a *indexRangesRebuildAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Recalculates the time ranges of Graylog indices, used to select the indices a search covers. Rebuilds the ranges of all indices when neither index_set_id nor index is set.",
+		Attributes: map[string]schema.Attribute{
+			"index_set_id": schema.StringAttribute{
+				Description: "The ID of the index set whose index ranges are rebuilt.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("index")),
+				},
+			},
+			"index": schema.StringAttribute{
+				Description: "The name of a single index whose range is rebuilt.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *indexRangesRebuildAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke starts the index range recalculation.
+func (a *indexRangesRebuildAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config indexRangesRebuildActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	switch {
+	case !config.Index.IsNull():
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Rebuilding the range of index " + config.Index.ValueString(),
+		})
+		err = a.client.RebuildIndexRange(ctx, config.Index.ValueString())
+	case !config.IndexSetID.IsNull():
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Rebuilding the index ranges of index set " + config.IndexSetID.ValueString(),
+		})
+		err = a.client.RebuildIndexRanges(ctx, config.IndexSetID.ValueString())
+	default:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Rebuilding the ranges of all indices",
+		})
+		err = a.client.RebuildAllIndexRanges(ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Rebuilding Index Ranges",
+			"Could not rebuild index ranges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,91 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &indexSetCycleAction{}
+	_ action.ActionWithConfigure = &indexSetCycleAction{}
+)
+
+// NewIndexSetCycleAction is a helper function to simplify the provider implementation.
+func NewIndexSetCycleAction() action.Action {
+	return &indexSetCycleAction{}
+}
+
+// indexSetCycleAction is the action implementation.
+type indexSetCycleAction struct {
+	client *client.Client
+}
+
+// indexSetCycleActionModel maps the action schema data.
+type indexSetCycleActionModel struct {
+	IndexSetID types.String `tfsdk:"index_set_id"`
+}
+
+// Metadata returns the action type name.
+func (a *indexSetCycleAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_set_cycle"
+}
+
+// Schema defines the schema for the action.
+func (a *indexSetCycleAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the active write index of a Graylog index set. The previous write index is optimized afterwards unless index optimization is disabled for the index set.",
+		Attributes: map[string]schema.Attribute{
+			"index_set_id": schema.StringAttribute{
+				Description: "The ID of the index set to rotate.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *indexSetCycleAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke rotates the active write index of the index set.
+func (a *indexSetCycleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config indexSetCycleActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Cycling the write index of index set " + config.IndexSetID.ValueString(),
+	})
+
+	err := a.client.CycleDeflector(ctx, config.IndexSetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Cycling Index Set",
+			"Could not cycle the write index of index set ID "+config.IndexSetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
@@ -2,24 +2,108 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 )
 
+// listPageSize is the page size used when listing paginated collections
+const listPageSize = 100
+
+// Authentication modes of the client
+const (
+	// AuthModeBasic sends the username and password with every request
+	AuthModeBasic = "basic"
+	// AuthModeToken sends an access token with every request
+	AuthModeToken = "token"
+	// AuthModeSession logs in with the username and password once and sends
+	// the session ID with every request
+	AuthModeSession = "session"
+)
+
 // Client represents a Graylog API client
 type Client struct {
 	BaseURL      string
+	AuthMode     string
 	Username     string
 	Password     string
+	Token        string
 	HTTPClient   *http.Client
 	XRequestedBy string
 	APIVersion   string
+	// RetryMaxAttempts and RetryMaxDuration bound the retries of a request
+	RetryMaxAttempts int
+	RetryMaxDuration time.Duration
+
+	// sessionMu guards sessionID, the current session in session mode
+	sessionMu sync.Mutex
+	sessionID string
+}
+
+// APIError represents a non-2xx response returned by the Graylog API
+type APIError struct {
+	StatusCode int
+	// Type and Message are taken from the Graylog error body, if any
+	Type    string
+	Message string
+	// RequestID identifies the request in the server logs, if available
+	RequestID string
+	Body      string
 }
 
-// NewClient creates a new Graylog client
+// Error implements the error interface
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = e.Body
+	}
+
+	status := fmt.Sprintf("%d", e.StatusCode)
+	if e.Type != "" {
+		status += " " + e.Type
+	}
+
+	if e.RequestID != "" {
+		return fmt.Sprintf("API request failed with status %s: %s (request ID %s)", status, message, e.RequestID)
+	}
+	return fmt.Sprintf("API request failed with status %s: %s", status, message)
+}
+
+// newAPIError builds an APIError from an unsuccessful response
+func newAPIError(resp *http.Response) *APIError {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       string(bodyBytes),
+	}
+
+	var graylogErr struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(bodyBytes, &graylogErr); err == nil {
+		apiErr.Type = graylogErr.Type
+		apiErr.Message = graylogErr.Message
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err is caused by a 404 Not Found response
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// NewClient creates a new Graylog client authenticating with a username and
+// password
 func NewClient(baseURL, username, password *string) (*Client, error) {
 	if baseURL == nil || *baseURL == "" {
 		return nil, fmt.Errorf("base URL is required")
@@ -31,16 +115,53 @@ func NewClient(baseURL, username, password *string) (*Client, error) {
 		return nil, fmt.Errorf("password is required")
 	}
 
+	c := newClient(*baseURL, AuthModeBasic)
+	c.Username = *username
+	c.Password = *password
+	return c, nil
+}
+
+// NewClientWithToken creates a new Graylog client authenticating with an
+// access token
+func NewClientWithToken(baseURL, token *string) (*Client, error) {
+	if baseURL == nil || *baseURL == "" {
+		return nil, fmt.Errorf("base URL is required")
+	}
+	if token == nil || *token == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+
+	c := newClient(*baseURL, AuthModeToken)
+	c.Token = *token
+	return c, nil
+}
+
+// NewClientWithSession creates a new Graylog client that logs in with a
+// username and password on its first request and authenticates with the
+// session afterwards. Expired sessions are renewed automatically.
+func NewClientWithSession(baseURL, username, password *string) (*Client, error) {
+	c, err := NewClient(baseURL, username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	c.AuthMode = AuthModeSession
+	return c, nil
+}
+
+// newClient creates a client with the default settings
+func newClient(baseURL, authMode string) *Client {
 	return &Client{
-		BaseURL:  *baseURL,
-		Username: *username,
-		Password: *password,
+		BaseURL:  baseURL,
+		AuthMode: authMode,
 		HTTPClient: &http.Client{
 			Timeout: time.Second * 30,
 		},
-		XRequestedBy: "terraform-provider-graylog",
-		APIVersion:   "v3", // Default to v3, can be overridden
-	}, nil
+		XRequestedBy:     "terraform-provider-graylog",
+		APIVersion:       "v3", // Default to v3, can be overridden
+		RetryMaxAttempts: DefaultRetryMaxAttempts,
+		RetryMaxDuration: DefaultRetryMaxDuration,
+	}
 }
 
 // SetXRequestedBy sets the X-Requested-By header value
@@ -53,20 +174,99 @@ func (c *Client) SetAPIVersion(version string) {
 	c.APIVersion = version
 }
 
-// doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Response, error) {
-	var reqBody io.Reader
+// doRequest performs an HTTP request with authentication. Transient failures
+// are retried with a jittered exponential backoff, honoring the Retry-After
+// header, until RetryMaxAttempts or RetryMaxDuration is reached.
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body interface{}) (*http.Response, error) {
+	var jsonData []byte
 
 	if body != nil {
-		jsonData, err := json.Marshal(body)
+		var err error
+		jsonData, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonData)
 	}
 
 	url := fmt.Sprintf("%s/api/%s", c.BaseURL, endpoint)
-	req, err := http.NewRequest(method, url, reqBody)
+	idempotent := isIdempotent(method)
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		var wait time.Duration
+		resp, err := c.send(ctx, method, url, jsonData)
+		switch {
+		case err != nil:
+			if !isRetryableError(err, idempotent) {
+				return nil, err
+			}
+		case resp.StatusCode < 200 || resp.StatusCode >= 300:
+			// Check for HTTP errors
+			apiErr := newAPIError(resp)
+			resp.Body.Close()
+			if !idempotent || !isRetryableStatus(resp.StatusCode) {
+				return nil, apiErr
+			}
+			err = apiErr
+			wait = retryAfter(resp, time.Now())
+		default:
+			return resp, nil
+		}
+
+		if wait == 0 {
+			wait = backoff(attempt)
+		}
+		if attempt >= c.RetryMaxAttempts || time.Since(start)+wait > c.RetryMaxDuration {
+			if attempt > 1 {
+				return nil, fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+			}
+			return nil, err
+		}
+
+		if sleepErr := sleep(ctx, wait); sleepErr != nil {
+			return nil, fmt.Errorf("%w, last error: %w", sleepErr, err)
+		}
+	}
+}
+
+// send performs a single authenticated HTTP request. In session mode, a
+// request rejected with 401 Unauthorized is sent again once with a new session.
+func (c *Client) send(ctx context.Context, method, url string, jsonData []byte) (*http.Response, error) {
+	for renewed := false; ; renewed = true {
+		req, err := c.newRequest(ctx, method, url, jsonData)
+		if err != nil {
+			return nil, err
+		}
+
+		sessionID, err := c.authenticate(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		// Sessions expire after a period of inactivity
+		if resp.StatusCode != http.StatusUnauthorized || c.AuthMode != AuthModeSession || renewed {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+		c.invalidateSession(sessionID)
+	}
+}
+
+// newRequest creates an HTTP request with the common headers and without
+// authentication
+func (c *Client) newRequest(ctx context.Context, method, url string, jsonData []byte) (*http.Request, error) {
+	var reqBody io.Reader
+	if jsonData != nil {
+		reqBody = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -78,27 +278,32 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Res
 		req.Header.Set("X-Requested-By", c.XRequestedBy)
 	}
 
-	// Set basic authentication
-	req.SetBasicAuth(c.Username, c.Password)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
-	}
+	return req, nil
+}
 
-	// Check for HTTP errors
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+// authenticate sets the credentials of the configured authentication mode on
+// a request and returns the session ID used, if any
+func (c *Client) authenticate(ctx context.Context, req *http.Request) (string, error) {
+	switch c.AuthMode {
+	case AuthModeToken:
+		req.SetBasicAuth(c.Token, "token")
+	case AuthModeSession:
+		sessionID, err := c.session(ctx)
+		if err != nil {
+			return "", err
+		}
+		req.SetBasicAuth(sessionID, "session")
+		return sessionID, nil
+	default:
+		req.SetBasicAuth(c.Username, c.Password)
 	}
 
-	return resp, nil
+	return "", nil
 }
 
 // Get performs a GET request
-func (c *Client) Get(endpoint string, result interface{}) error {
-	resp, err := c.doRequest(http.MethodGet, endpoint, nil)
+func (c *Client) Get(ctx context.Context, endpoint string, result interface{}) error {
+	resp, err := c.doRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return err
 	}
@@ -114,8 +319,8 @@ func (c *Client) Get(endpoint string, result interface{}) error {
 }
 
 // Post performs a POST request
-func (c *Client) Post(endpoint string, body interface{}, result interface{}) error {
-	resp, err := c.doRequest(http.MethodPost, endpoint, body)
+func (c *Client) Post(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	resp, err := c.doRequest(ctx, http.MethodPost, endpoint, body)
 	if err != nil {
 		return err
 	}
@@ -131,8 +336,8 @@ func (c *Client) Post(endpoint string, body interface{}, result interface{}) err
 }
 
 // Put performs a PUT request
-func (c *Client) Put(endpoint string, body interface{}, result interface{}) error {
-	resp, err := c.doRequest(http.MethodPut, endpoint, body)
+func (c *Client) Put(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	resp, err := c.doRequest(ctx, http.MethodPut, endpoint, body)
 	if err != nil {
 		return err
 	}
@@ -148,8 +353,8 @@ func (c *Client) Put(endpoint string, body interface{}, result interface{}) erro
 }
 
 // Delete performs a DELETE request
-func (c *Client) Delete(endpoint string) error {
-	resp, err := c.doRequest(http.MethodDelete, endpoint, nil)
+func (c *Client) Delete(ctx context.Context, endpoint string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return err
 	}
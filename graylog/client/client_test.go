@@ -1,6 +1,11 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -122,8 +127,8 @@ func TestSetAPIVersion(t *testing.T) {
 	}
 }
 
-// TestDashboardValidation tests dashboard operation validation
-func TestDashboardValidation(t *testing.T) {
+// TestStreamValidation tests stream operation validation
+func TestStreamValidation(t *testing.T) {
 	baseURL := "https://graylog.example.com"
 	username := "admin"
 	password := "password"
@@ -133,55 +138,232 @@ func TestDashboardValidation(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	t.Run("GetDashboard with empty ID", func(t *testing.T) {
-		_, err := client.GetDashboard("")
+	ctx := context.Background()
+
+	t.Run("GetStream with empty ID", func(t *testing.T) {
+		_, err := client.GetStream(ctx, "")
 		if err == nil {
-			t.Error("Expected error for empty dashboard ID")
+			t.Error("Expected error for empty stream ID")
 		}
 	})
 
-	t.Run("DeleteDashboard with empty ID", func(t *testing.T) {
-		err := client.DeleteDashboard("")
+	t.Run("DeleteStream with empty ID", func(t *testing.T) {
+		err := client.DeleteStream(ctx, "")
 		if err == nil {
-			t.Error("Expected error for empty dashboard ID")
+			t.Error("Expected error for empty stream ID")
 		}
 	})
 
-	t.Run("CreateDashboard with nil request", func(t *testing.T) {
-		_, err := client.CreateDashboard(nil)
+	t.Run("CreateStream with nil request", func(t *testing.T) {
+		_, err := client.CreateStream(ctx, nil)
 		if err == nil {
 			t.Error("Expected error for nil request")
 		}
 	})
 
-	t.Run("CreateDashboard with empty title", func(t *testing.T) {
-		req := &CreateDashboardRequest{Title: ""}
-		_, err := client.CreateDashboard(req)
+	t.Run("CreateStream with empty title", func(t *testing.T) {
+		req := &CreateStreamRequest{Title: ""}
+		_, err := client.CreateStream(ctx, req)
 		if err == nil {
 			t.Error("Expected error for empty title")
 		}
 	})
 
-	t.Run("UpdateDashboard with empty ID", func(t *testing.T) {
-		req := &UpdateDashboardRequest{Title: "Test"}
-		_, err := client.UpdateDashboard("", req)
+	t.Run("UpdateStream with empty ID", func(t *testing.T) {
+		req := &UpdateStreamRequest{Title: "Test"}
+		_, err := client.UpdateStream(ctx, "", req)
 		if err == nil {
-			t.Error("Expected error for empty dashboard ID")
+			t.Error("Expected error for empty stream ID")
 		}
 	})
 
-	t.Run("UpdateDashboard with nil request", func(t *testing.T) {
-		_, err := client.UpdateDashboard("test-id", nil)
+	t.Run("UpdateStream with nil request", func(t *testing.T) {
+		_, err := client.UpdateStream(ctx, "test-id", nil)
 		if err == nil {
 			t.Error("Expected error for nil request")
 		}
 	})
 
-	t.Run("UpdateDashboard with empty title", func(t *testing.T) {
-		req := &UpdateDashboardRequest{Title: ""}
-		_, err := client.UpdateDashboard("test-id", req)
+	t.Run("UpdateStream with empty title", func(t *testing.T) {
+		req := &UpdateStreamRequest{Title: ""}
+		_, err := client.UpdateStream(ctx, "test-id", req)
 		if err == nil {
 			t.Error("Expected error for empty title")
 		}
 	})
 }
+
+// TestNewClientWithToken tests the NewClientWithToken function
+func TestNewClientWithToken(t *testing.T) {
+	baseURL := "https://graylog.example.com"
+	token := "abc123"
+	empty := ""
+
+	tests := []struct {
+		name        string
+		baseURL     *string
+		token       *string
+		expectError bool
+	}{
+		{
+			name:        "Valid token",
+			baseURL:     &baseURL,
+			token:       &token,
+			expectError: false,
+		},
+		{
+			name:        "Nil base URL",
+			baseURL:     nil,
+			token:       &token,
+			expectError: true,
+		},
+		{
+			name:        "Nil token",
+			baseURL:     &baseURL,
+			token:       nil,
+			expectError: true,
+		},
+		{
+			name:        "Empty token",
+			baseURL:     &baseURL,
+			token:       &empty,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientWithToken(tt.baseURL, tt.token)
+
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if !tt.expectError && client != nil {
+				if client.AuthMode != AuthModeToken {
+					t.Errorf("Expected AuthMode %s, got %s", AuthModeToken, client.AuthMode)
+				}
+				if client.Token != *tt.token {
+					t.Errorf("Expected Token %s, got %s", *tt.token, client.Token)
+				}
+			}
+		})
+	}
+}
+
+// TestAuthentication tests the credentials sent by each authentication mode
+func TestAuthentication(t *testing.T) {
+	var username, password string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ = r.BasicAuth()
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	baseURL := server.URL
+	name := "admin"
+	secret := "password"
+	token := "abc123"
+
+	basicClient, err := NewClient(&baseURL, &name, &secret)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	tokenClient, err := NewClientWithToken(&baseURL, &token)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		client         *Client
+		expectUsername string
+		expectPassword string
+	}{
+		{
+			name:           "Basic",
+			client:         basicClient,
+			expectUsername: name,
+			expectPassword: secret,
+		},
+		{
+			name:           "Token",
+			client:         tokenClient,
+			expectUsername: token,
+			expectPassword: "token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.client.Get(context.Background(), "system", nil); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if username != tt.expectUsername || password != tt.expectPassword {
+				t.Errorf("Expected credentials %s:%s, got %s:%s", tt.expectUsername, tt.expectPassword, username, password)
+			}
+		})
+	}
+}
+
+// TestConverterMapMarshalJSON tests that converters are encoded as a JSON
+// object in the order they are applied
+func TestConverterMapMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		converters ConverterMap
+		expected   string
+	}{
+		{
+			name:       "No converters",
+			converters: ConverterMap{},
+			expected:   `{}`,
+		},
+		{
+			name:       "Nil config",
+			converters: ConverterMap{{Type: "numeric"}},
+			expected:   `{"numeric":{}}`,
+		},
+		{
+			name: "Order is kept",
+			converters: ConverterMap{
+				{Type: "uppercase"},
+				{Type: "date", Config: map[string]interface{}{"date_format": "yyyy-MM-dd"}},
+				{Type: "csv", Config: map[string]interface{}{"column_header": "a,b", "strict_quotes": true}},
+				{Type: "numeric", Config: map[string]interface{}{}},
+			},
+			expected: `{"uppercase":{},"date":{"date_format":"yyyy-MM-dd"},"csv":{"column_header":"a,b","strict_quotes":true},"numeric":{}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.converters)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+
+	t.Run("Within extractor request", func(t *testing.T) {
+		data, err := json.Marshal(&ExtractorRequest{
+			Converters: ConverterMap{{Type: "lowercase"}, {Type: "hash"}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !strings.Contains(string(data), `"converters":{"lowercase":{},"hash":{}}`) {
+			t.Errorf("Expected ordered converters in %s", data)
+		}
+	})
+}
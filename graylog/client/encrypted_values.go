@@ -0,0 +1,19 @@
+package client
+
+// EncryptedValue is the write format of encrypted configuration values.
+// Graylog never returns the secret itself; reads only report whether a value
+// is set, so updates either replace or keep the stored secret.
+type EncryptedValue struct {
+	SetValue  string `json:"set_value,omitempty"`
+	KeepValue bool   `json:"keep_value,omitempty"`
+}
+
+// SetEncryptedValue returns an encrypted value replacing the stored secret
+func SetEncryptedValue(value string) EncryptedValue {
+	return EncryptedValue{SetValue: value}
+}
+
+// KeepEncryptedValue returns an encrypted value keeping the stored secret
+func KeepEncryptedValue() EncryptedValue {
+	return EncryptedValue{KeepValue: true}
+}
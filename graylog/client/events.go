@@ -1,26 +1,27 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
 // EventDefinition represents a Graylog event definition
 type EventDefinition struct {
-	ID                     string                 `json:"id,omitempty"`
-	Title                  string                 `json:"title"`
-	Description            string                 `json:"description,omitempty"`
-	Priority               int                    `json:"priority,omitempty"`
-	Alert                  bool                   `json:"alert,omitempty"`
-	Config                 map[string]interface{} `json:"config,omitempty"`
-	FieldSpec              map[string]interface{} `json:"field_spec,omitempty"`
-	KeySpec                []interface{}          `json:"key_spec,omitempty"`
-	NotificationSettings   NotificationSettings   `json:"notification_settings,omitempty"`
-	Notifications          []Notification         `json:"notifications,omitempty"`
-	Storage                []Storage              `json:"storage,omitempty"`
-	State                  string                 `json:"state,omitempty"`
-	UpdatedAt              time.Time              `json:"updated_at,omitempty"`
-	MatchedAt              time.Time              `json:"matched_at,omitempty"`
+	ID                   string                    `json:"id,omitempty"`
+	Title                string                    `json:"title"`
+	Description          string                    `json:"description,omitempty"`
+	Priority             int                       `json:"priority,omitempty"`
+	Alert                bool                      `json:"alert,omitempty"`
+	Config               map[string]interface{}    `json:"config,omitempty"`
+	FieldSpec            map[string]EventFieldSpec `json:"field_spec,omitempty"`
+	KeySpec              []string                  `json:"key_spec,omitempty"`
+	NotificationSettings NotificationSettings      `json:"notification_settings,omitempty"`
+	Notifications        []Notification            `json:"notifications,omitempty"`
+	Storage              []Storage                 `json:"storage,omitempty"`
+	State                string                    `json:"state,omitempty"`
+	UpdatedAt            time.Time                 `json:"updated_at,omitempty"`
+	MatchedAt            time.Time                 `json:"matched_at,omitempty"`
 }
 
 // NotificationSettings represents notification settings for an event
@@ -34,6 +35,21 @@ type Notification struct {
 	NotificationID string `json:"notification_id,omitempty"`
 }
 
+// EventFieldSpec represents a custom event field and how its value is computed
+type EventFieldSpec struct {
+	DataType  string                    `json:"data_type"`
+	Providers []EventFieldValueProvider `json:"providers"`
+}
+
+// EventFieldValueProvider computes the value of a custom event field
+type EventFieldValueProvider struct {
+	Type          string `json:"type"`
+	Template      string `json:"template,omitempty"`
+	RequireValues *bool  `json:"require_values,omitempty"`
+	TableName     string `json:"table_name,omitempty"`
+	KeyField      string `json:"key_field,omitempty"`
+}
+
 // Storage represents storage configuration for an event
 type Storage struct {
 	Type    string   `json:"type,omitempty"`
@@ -50,7 +66,7 @@ type EventDefinitionsListResponse struct {
 }
 
 // GetEventDefinition retrieves an event definition by ID
-func (c *Client) GetEventDefinition(id string) (*EventDefinition, error) {
+func (c *Client) GetEventDefinition(ctx context.Context, id string) (*EventDefinition, error) {
 	if id == "" {
 		return nil, fmt.Errorf("event definition ID is required")
 	}
@@ -58,28 +74,35 @@ func (c *Client) GetEventDefinition(id string) (*EventDefinition, error) {
 	endpoint := fmt.Sprintf("events/definitions/%s", id)
 	var eventDef EventDefinition
 
-	if err := c.Get(endpoint, &eventDef); err != nil {
+	if err := c.Get(ctx, endpoint, &eventDef); err != nil {
 		return nil, fmt.Errorf("failed to get event definition: %w", err)
 	}
 
 	return &eventDef, nil
 }
 
-// ListEventDefinitions retrieves all event definitions
-func (c *Client) ListEventDefinitions() ([]EventDefinition, error) {
-	endpoint := "events/definitions"
-	var response EventDefinitionsListResponse
+// ListEventDefinitions retrieves all event definitions, page by page
+func (c *Client) ListEventDefinitions(ctx context.Context) ([]EventDefinition, error) {
+	var eventDefs []EventDefinition
 
-	if err := c.Get(endpoint, &response); err != nil {
-		return nil, fmt.Errorf("failed to list event definitions: %w", err)
-	}
+	for page := 1; ; page++ {
+		endpoint := fmt.Sprintf("events/definitions?page=%d&per_page=%d", page, listPageSize)
+		var response EventDefinitionsListResponse
+
+		if err := c.Get(ctx, endpoint, &response); err != nil {
+			return nil, fmt.Errorf("failed to list event definitions: %w", err)
+		}
 
-	return response.EventDefinitions, nil
+		eventDefs = append(eventDefs, response.EventDefinitions...)
+		if len(response.EventDefinitions) == 0 || len(eventDefs) >= response.Total {
+			return eventDefs, nil
+		}
+	}
 }
 
 // SearchEventDefinitionsByTitle searches for event definitions by title
-func (c *Client) SearchEventDefinitionsByTitle(title string) ([]EventDefinition, error) {
-	eventDefs, err := c.ListEventDefinitions()
+func (c *Client) SearchEventDefinitionsByTitle(ctx context.Context, title string) ([]EventDefinition, error) {
+	eventDefs, err := c.ListEventDefinitions(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -103,16 +126,16 @@ type CreateEventDefinitionRequest struct {
 
 // EventDefinitionEntity represents the event definition entity for create/update
 type EventDefinitionEntity struct {
-	Title                string                 `json:"title"`
-	Description          string                 `json:"description,omitempty"`
-	Priority             int                    `json:"priority"`
-	Alert                bool                   `json:"alert"`
-	Config               map[string]interface{} `json:"config"`
-	FieldSpec            map[string]interface{} `json:"field_spec"`
-	KeySpec              []interface{}          `json:"key_spec"`
-	NotificationSettings NotificationSettings   `json:"notification_settings"`
-	Notifications        []Notification         `json:"notifications"`
-	Storage              []Storage              `json:"storage"`
+	Title                string                    `json:"title"`
+	Description          string                    `json:"description,omitempty"`
+	Priority             int                       `json:"priority"`
+	Alert                bool                      `json:"alert"`
+	Config               map[string]interface{}    `json:"config"`
+	FieldSpec            map[string]EventFieldSpec `json:"field_spec"`
+	KeySpec              []string                  `json:"key_spec"`
+	NotificationSettings NotificationSettings      `json:"notification_settings"`
+	Notifications        []Notification            `json:"notifications"`
+	Storage              []Storage                 `json:"storage"`
 }
 
 // EntityShareRequest represents sharing/permissions for the entity
@@ -122,21 +145,21 @@ type EntityShareRequest struct {
 
 // UpdateEventDefinitionRequest represents the request to update an event definition
 type UpdateEventDefinitionRequest struct {
-	ID                   string                 `json:"id"`
-	Title                string                 `json:"title"`
-	Description          string                 `json:"description,omitempty"`
-	Priority             int                    `json:"priority"`
-	Alert                bool                   `json:"alert"`
-	Config               map[string]interface{} `json:"config"`
-	FieldSpec            map[string]interface{} `json:"field_spec"`
-	KeySpec              []interface{}          `json:"key_spec"`
-	NotificationSettings NotificationSettings   `json:"notification_settings"`
-	Notifications        []Notification         `json:"notifications"`
-	Storage              []Storage              `json:"storage"`
+	ID                   string                    `json:"id"`
+	Title                string                    `json:"title"`
+	Description          string                    `json:"description,omitempty"`
+	Priority             int                       `json:"priority"`
+	Alert                bool                      `json:"alert"`
+	Config               map[string]interface{}    `json:"config"`
+	FieldSpec            map[string]EventFieldSpec `json:"field_spec"`
+	KeySpec              []string                  `json:"key_spec"`
+	NotificationSettings NotificationSettings      `json:"notification_settings"`
+	Notifications        []Notification            `json:"notifications"`
+	Storage              []Storage                 `json:"storage"`
 }
 
 // CreateEventDefinition creates a new event definition
-func (c *Client) CreateEventDefinition(req *CreateEventDefinitionRequest) (*EventDefinition, error) {
+func (c *Client) CreateEventDefinition(ctx context.Context, req *CreateEventDefinitionRequest) (*EventDefinition, error) {
 	if req == nil {
 		return nil, fmt.Errorf("create event definition request is required")
 	}
@@ -148,7 +171,7 @@ func (c *Client) CreateEventDefinition(req *CreateEventDefinitionRequest) (*Even
 	endpoint := "events/definitions"
 	var eventDef EventDefinition
 
-	if err := c.Post(endpoint, req, &eventDef); err != nil {
+	if err := c.Post(ctx, endpoint, req, &eventDef); err != nil {
 		return nil, fmt.Errorf("failed to create event definition: %w", err)
 	}
 
@@ -156,7 +179,7 @@ func (c *Client) CreateEventDefinition(req *CreateEventDefinitionRequest) (*Even
 }
 
 // UpdateEventDefinition updates an existing event definition
-func (c *Client) UpdateEventDefinition(id string, req *UpdateEventDefinitionRequest) (*EventDefinition, error) {
+func (c *Client) UpdateEventDefinition(ctx context.Context, id string, req *UpdateEventDefinitionRequest) (*EventDefinition, error) {
 	if id == "" {
 		return nil, fmt.Errorf("event definition ID is required")
 	}
@@ -172,23 +195,23 @@ func (c *Client) UpdateEventDefinition(id string, req *UpdateEventDefinitionRequ
 	endpoint := fmt.Sprintf("events/definitions/%s", id)
 	var eventDef EventDefinition
 
-	if err := c.Put(endpoint, req, &eventDef); err != nil {
+	if err := c.Put(ctx, endpoint, req, &eventDef); err != nil {
 		return nil, fmt.Errorf("failed to update event definition: %w", err)
 	}
 
 	// Fetch the updated event definition to get complete state
-	return c.GetEventDefinition(id)
+	return c.GetEventDefinition(ctx, id)
 }
 
 // DeleteEventDefinition deletes an event definition by ID
-func (c *Client) DeleteEventDefinition(id string) error {
+func (c *Client) DeleteEventDefinition(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("event definition ID is required")
 	}
 
 	endpoint := fmt.Sprintf("events/definitions/%s", id)
 
-	if err := c.Delete(endpoint); err != nil {
+	if err := c.Delete(ctx, endpoint); err != nil {
 		return fmt.Errorf("failed to delete event definition: %w", err)
 	}
 
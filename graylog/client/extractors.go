@@ -0,0 +1,170 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Extractor represents an extractor of a Graylog input
+type Extractor struct {
+	ID              string                 `json:"id"`
+	Title           string                 `json:"title"`
+	Type            string                 `json:"type"`
+	CursorStrategy  string                 `json:"cursor_strategy"`
+	SourceField     string                 `json:"source_field"`
+	TargetField     string                 `json:"target_field"`
+	ExtractorConfig map[string]interface{} `json:"extractor_config"`
+	CreatorUserID   string                 `json:"creator_user_id,omitempty"`
+	Converters      []ExtractorConverter   `json:"converters"`
+	ConditionType   string                 `json:"condition_type"`
+	ConditionValue  string                 `json:"condition_value"`
+	Order           int64                  `json:"order"`
+}
+
+// ExtractorConverter represents a converter applied to the extracted value
+type ExtractorConverter struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config"`
+}
+
+// ConverterMap holds the converters of an extractor request, keyed by type.
+// Graylog applies the converters in the order they appear in the request.
+type ConverterMap []ExtractorConverter
+
+// MarshalJSON encodes the converters as a JSON object keeping their order
+func (m ConverterMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, converter := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(converter.Type)
+		if err != nil {
+			return nil, err
+		}
+		config := converter.Config
+		if config == nil {
+			config = map[string]interface{}{}
+		}
+		value, err := json.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// ExtractorRequest represents the request to create or update an extractor
+type ExtractorRequest struct {
+	Title           string                 `json:"title"`
+	CutOrCopy       string                 `json:"cut_or_copy"`
+	SourceField     string                 `json:"source_field"`
+	TargetField     string                 `json:"target_field"`
+	ExtractorType   string                 `json:"extractor_type"`
+	ExtractorConfig map[string]interface{} `json:"extractor_config"`
+	Converters      ConverterMap           `json:"converters"`
+	ConditionType   string                 `json:"condition_type"`
+	ConditionValue  string                 `json:"condition_value"`
+	Order           int64                  `json:"order"`
+}
+
+// GetExtractor retrieves an extractor of an input by ID
+func (c *Client) GetExtractor(ctx context.Context, inputID, id string) (*Extractor, error) {
+	if inputID == "" {
+		return nil, fmt.Errorf("input ID is required")
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("extractor ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/%s/extractors/%s", inputID, id)
+	var extractor Extractor
+
+	if err := c.Get(ctx, endpoint, &extractor); err != nil {
+		return nil, fmt.Errorf("failed to get extractor: %w", err)
+	}
+
+	return &extractor, nil
+}
+
+// CreateExtractor creates a new extractor on an input
+func (c *Client) CreateExtractor(ctx context.Context, inputID string, req *ExtractorRequest) (*Extractor, error) {
+	if inputID == "" {
+		return nil, fmt.Errorf("input ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("create extractor request is required")
+	}
+
+	if req.Title == "" {
+		return nil, fmt.Errorf("extractor title is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/%s/extractors", inputID)
+	var response map[string]string
+
+	if err := c.Post(ctx, endpoint, req, &response); err != nil {
+		return nil, fmt.Errorf("failed to create extractor: %w", err)
+	}
+
+	// Get the created extractor ID from response
+	extractorID, ok := response["extractor_id"]
+	if !ok {
+		return nil, fmt.Errorf("extractor creation did not return an ID")
+	}
+
+	// Fetch the created extractor
+	return c.GetExtractor(ctx, inputID, extractorID)
+}
+
+// UpdateExtractor updates an existing extractor
+func (c *Client) UpdateExtractor(ctx context.Context, inputID, id string, req *ExtractorRequest) (*Extractor, error) {
+	if inputID == "" {
+		return nil, fmt.Errorf("input ID is required")
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("extractor ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("update extractor request is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/%s/extractors/%s", inputID, id)
+
+	if err := c.Put(ctx, endpoint, req, nil); err != nil {
+		return nil, fmt.Errorf("failed to update extractor: %w", err)
+	}
+
+	// Fetch the updated extractor to get complete state
+	return c.GetExtractor(ctx, inputID, id)
+}
+
+// DeleteExtractor deletes an extractor by ID
+func (c *Client) DeleteExtractor(ctx context.Context, inputID, id string) error {
+	if inputID == "" {
+		return fmt.Errorf("input ID is required")
+	}
+
+	if id == "" {
+		return fmt.Errorf("extractor ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/%s/extractors/%s", inputID, id)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete extractor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// IndexFieldTypes lists the field types usable in custom field mappings
+var IndexFieldTypes = []string{
+	"binary",
+	"boolean",
+	"byte",
+	"date",
+	"double",
+	"float",
+	"geo-point",
+	"int",
+	"ip",
+	"long",
+	"short",
+	"string",
+	"string_fts",
+}
+
+// CustomFieldMapping represents the type of a field in the indices of an index set
+type CustomFieldMapping struct {
+	Field string `json:"field"`
+	Type  string `json:"type"`
+}
+
+// IndexFieldTypeProfile represents a Graylog index field type profile
+type IndexFieldTypeProfile struct {
+	ID                  string               `json:"id,omitempty"`
+	Name                string               `json:"name"`
+	Description         string               `json:"description"`
+	CustomFieldMappings []CustomFieldMapping `json:"custom_field_mappings"`
+	IndexSetIDs         []string             `json:"index_set_ids,omitempty"`
+}
+
+// IndexFieldTypeProfileRequest represents the request to create or update an index field type profile
+type IndexFieldTypeProfileRequest struct {
+	ID                  string               `json:"id,omitempty"`
+	Name                string               `json:"name"`
+	Description         string               `json:"description"`
+	CustomFieldMappings []CustomFieldMapping `json:"custom_field_mappings"`
+}
+
+// FieldTypeChangeRequest represents the request to set the type of a field in index sets
+type FieldTypeChangeRequest struct {
+	IndexSets []string `json:"index_sets"`
+	Field     string   `json:"field"`
+	Type      string   `json:"type"`
+	Rotate    bool     `json:"rotate"`
+}
+
+// FieldTypeRemovalRequest represents the request to remove custom field mappings from index sets
+type FieldTypeRemovalRequest struct {
+	IndexSets []string `json:"index_sets"`
+	Fields    []string `json:"fields"`
+	Rotate    bool     `json:"rotate"`
+}
+
+// GetIndexFieldTypeProfile retrieves an index field type profile by ID
+func (c *Client) GetIndexFieldTypeProfile(ctx context.Context, id string) (*IndexFieldTypeProfile, error) {
+	if id == "" {
+		return nil, fmt.Errorf("index field type profile ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/indices/index_sets/profiles/%s", id)
+	var profile IndexFieldTypeProfile
+
+	if err := c.Get(ctx, endpoint, &profile); err != nil {
+		return nil, fmt.Errorf("failed to get index field type profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// CreateIndexFieldTypeProfile creates a new index field type profile
+func (c *Client) CreateIndexFieldTypeProfile(ctx context.Context, req *IndexFieldTypeProfileRequest) (*IndexFieldTypeProfile, error) {
+	if req == nil {
+		return nil, fmt.Errorf("create index field type profile request is required")
+	}
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("index field type profile name is required")
+	}
+
+	endpoint := "system/indices/index_sets/profiles"
+	var profile IndexFieldTypeProfile
+
+	if err := c.Post(ctx, endpoint, req, &profile); err != nil {
+		return nil, fmt.Errorf("failed to create index field type profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// UpdateIndexFieldTypeProfile updates an existing index field type profile
+func (c *Client) UpdateIndexFieldTypeProfile(ctx context.Context, id string, req *IndexFieldTypeProfileRequest) (*IndexFieldTypeProfile, error) {
+	if id == "" {
+		return nil, fmt.Errorf("index field type profile ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("update index field type profile request is required")
+	}
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("index field type profile name is required")
+	}
+
+	// The profile is identified by the ID in the request body
+	req.ID = id
+	endpoint := "system/indices/index_sets/profiles"
+
+	if err := c.Put(ctx, endpoint, req, nil); err != nil {
+		return nil, fmt.Errorf("failed to update index field type profile: %w", err)
+	}
+
+	// Fetch the updated profile to get complete state
+	return c.GetIndexFieldTypeProfile(ctx, id)
+}
+
+// DeleteIndexFieldTypeProfile deletes an index field type profile by ID
+func (c *Client) DeleteIndexFieldTypeProfile(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("index field type profile ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/indices/index_sets/profiles/%s", id)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete index field type profile: %w", err)
+	}
+
+	return nil
+}
+
+// SetFieldType sets a custom field mapping on index sets
+func (c *Client) SetFieldType(ctx context.Context, req *FieldTypeChangeRequest) error {
+	if req == nil {
+		return fmt.Errorf("field type change request is required")
+	}
+
+	if len(req.IndexSets) == 0 {
+		return fmt.Errorf("at least one index set is required")
+	}
+
+	if req.Field == "" || req.Type == "" {
+		return fmt.Errorf("field and type are required")
+	}
+
+	endpoint := "system/indices/mappings"
+
+	if err := c.Put(ctx, endpoint, req, nil); err != nil {
+		return fmt.Errorf("failed to set field type: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFieldTypes removes custom field mappings from index sets
+func (c *Client) RemoveFieldTypes(ctx context.Context, req *FieldTypeRemovalRequest) error {
+	if req == nil {
+		return fmt.Errorf("field type removal request is required")
+	}
+
+	if len(req.IndexSets) == 0 || len(req.Fields) == 0 {
+		return fmt.Errorf("index sets and fields are required")
+	}
+
+	endpoint := "system/indices/mappings/remove_mapping"
+
+	if err := c.Put(ctx, endpoint, req, nil); err != nil {
+		return fmt.Errorf("failed to remove field types: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -25,18 +26,27 @@ type IndexSet struct {
 	Writable                          bool                   `json:"writable,omitempty"`
 	Default                           bool                   `json:"default,omitempty"`
 	FieldTypeProfile                  *string                `json:"field_type_profile,omitempty"`
+	CustomFieldMappings               []CustomFieldMapping   `json:"custom_field_mappings,omitempty"`
 	DataTiering                       map[string]interface{} `json:"data_tiering,omitempty"`
 	UseLegacyRotation                 bool                   `json:"use_legacy_rotation"`
 }
 
 // IndexSetsListResponse represents the response from listing index sets
 type IndexSetsListResponse struct {
-	Total     int        `json:"total"`
-	IndexSets []IndexSet `json:"index_sets"`
+	Total     int                      `json:"total"`
+	IndexSets []IndexSet               `json:"index_sets"`
+	Stats     map[string]IndexSetStats `json:"stats,omitempty"`
+}
+
+// IndexSetStats represents the statistics of an index set
+type IndexSetStats struct {
+	Indices   int64 `json:"indices"`
+	Documents int64 `json:"documents"`
+	Size      int64 `json:"size"`
 }
 
 // GetIndexSet retrieves an index set by ID
-func (c *Client) GetIndexSet(id string) (*IndexSet, error) {
+func (c *Client) GetIndexSet(ctx context.Context, id string) (*IndexSet, error) {
 	if id == "" {
 		return nil, fmt.Errorf("index set ID is required")
 	}
@@ -44,7 +54,7 @@ func (c *Client) GetIndexSet(id string) (*IndexSet, error) {
 	endpoint := fmt.Sprintf("system/indices/index_sets/%s", id)
 	var indexSet IndexSet
 
-	if err := c.Get(endpoint, &indexSet); err != nil {
+	if err := c.Get(ctx, endpoint, &indexSet); err != nil {
 		return nil, fmt.Errorf("failed to get index set: %w", err)
 	}
 
@@ -52,20 +62,33 @@ func (c *Client) GetIndexSet(id string) (*IndexSet, error) {
 }
 
 // ListIndexSets retrieves all index sets
-func (c *Client) ListIndexSets() ([]IndexSet, error) {
+func (c *Client) ListIndexSets(ctx context.Context) ([]IndexSet, error) {
 	endpoint := "system/indices/index_sets"
 	var response IndexSetsListResponse
 
-	if err := c.Get(endpoint, &response); err != nil {
+	if err := c.Get(ctx, endpoint, &response); err != nil {
 		return nil, fmt.Errorf("failed to list index sets: %w", err)
 	}
 
 	return response.IndexSets, nil
 }
 
+// ListIndexSetsWithStats retrieves all index sets along with their
+// statistics, keyed by index set ID
+func (c *Client) ListIndexSetsWithStats(ctx context.Context) ([]IndexSet, map[string]IndexSetStats, error) {
+	endpoint := "system/indices/index_sets?stats=true"
+	var response IndexSetsListResponse
+
+	if err := c.Get(ctx, endpoint, &response); err != nil {
+		return nil, nil, fmt.Errorf("failed to list index sets: %w", err)
+	}
+
+	return response.IndexSets, response.Stats, nil
+}
+
 // SearchIndexSetsByTitle searches for index sets by title
-func (c *Client) SearchIndexSetsByTitle(title string) ([]IndexSet, error) {
-	indexSets, err := c.ListIndexSets()
+func (c *Client) SearchIndexSetsByTitle(ctx context.Context, title string) ([]IndexSet, error) {
+	indexSets, err := c.ListIndexSets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +121,7 @@ type CreateIndexSetRequest struct {
 	UseLegacyRotation               bool                   `json:"use_legacy_rotation"`
 	Writable                        bool                   `json:"writable"`
 	DataTiering                     map[string]interface{} `json:"data_tiering"`
+	FieldTypeProfile                *string                `json:"field_type_profile,omitempty"`
 }
 
 // UpdateIndexSetRequest represents the request to update an index set
@@ -115,10 +139,12 @@ type UpdateIndexSetRequest struct {
 	IndexOptimizationDisabled       bool                   `json:"index_optimization_disabled"`
 	FieldTypeRefreshInterval        int                    `json:"field_type_refresh_interval"`
 	UseLegacyRotation               bool                   `json:"use_legacy_rotation"`
+	DataTiering                     map[string]interface{} `json:"data_tiering,omitempty"`
+	FieldTypeProfile                *string                `json:"field_type_profile,omitempty"`
 }
 
 // CreateIndexSet creates a new index set
-func (c *Client) CreateIndexSet(req *CreateIndexSetRequest) (*IndexSet, error) {
+func (c *Client) CreateIndexSet(ctx context.Context, req *CreateIndexSetRequest) (*IndexSet, error) {
 	if req == nil {
 		return nil, fmt.Errorf("create index set request is required")
 	}
@@ -134,7 +160,7 @@ func (c *Client) CreateIndexSet(req *CreateIndexSetRequest) (*IndexSet, error) {
 	endpoint := "system/indices/index_sets"
 	var indexSet IndexSet
 
-	if err := c.Post(endpoint, req, &indexSet); err != nil {
+	if err := c.Post(ctx, endpoint, req, &indexSet); err != nil {
 		return nil, fmt.Errorf("failed to create index set: %w", err)
 	}
 
@@ -142,7 +168,7 @@ func (c *Client) CreateIndexSet(req *CreateIndexSetRequest) (*IndexSet, error) {
 }
 
 // UpdateIndexSet updates an existing index set
-func (c *Client) UpdateIndexSet(id string, req *UpdateIndexSetRequest) (*IndexSet, error) {
+func (c *Client) UpdateIndexSet(ctx context.Context, id string, req *UpdateIndexSetRequest) (*IndexSet, error) {
 	if id == "" {
 		return nil, fmt.Errorf("index set ID is required")
 	}
@@ -158,24 +184,41 @@ func (c *Client) UpdateIndexSet(id string, req *UpdateIndexSetRequest) (*IndexSe
 	endpoint := fmt.Sprintf("system/indices/index_sets/%s", id)
 	var indexSet IndexSet
 
-	if err := c.Put(endpoint, req, &indexSet); err != nil {
+	if err := c.Put(ctx, endpoint, req, &indexSet); err != nil {
 		return nil, fmt.Errorf("failed to update index set: %w", err)
 	}
 
 	return &indexSet, nil
 }
 
-// DeleteIndexSet deletes an index set by ID
-func (c *Client) DeleteIndexSet(id string) error {
+// DeleteIndexSet deletes an index set by ID. The indices of the index set are
+// only deleted when deleteIndices is true.
+func (c *Client) DeleteIndexSet(ctx context.Context, id string, deleteIndices bool) error {
 	if id == "" {
 		return fmt.Errorf("index set ID is required")
 	}
 
-	endpoint := fmt.Sprintf("system/indices/index_sets/%s", id)
+	endpoint := fmt.Sprintf("system/indices/index_sets/%s?delete_indices=%t", id, deleteIndices)
 
-	if err := c.Delete(endpoint); err != nil {
+	if err := c.Delete(ctx, endpoint); err != nil {
 		return fmt.Errorf("failed to delete index set: %w", err)
 	}
 
 	return nil
 }
+
+// SetDefaultIndexSet makes an index set the default index set
+func (c *Client) SetDefaultIndexSet(ctx context.Context, id string) (*IndexSet, error) {
+	if id == "" {
+		return nil, fmt.Errorf("index set ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/indices/index_sets/%s/default", id)
+	var indexSet IndexSet
+
+	if err := c.Put(ctx, endpoint, nil, &indexSet); err != nil {
+		return nil, fmt.Errorf("failed to set default index set: %w", err)
+	}
+
+	return &indexSet, nil
+}
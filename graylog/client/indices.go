@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CycleDeflector rotates the active write index of an index set. Graylog
+// optimizes the previous write index afterwards unless index optimization is
+// disabled for the index set.
+func (c *Client) CycleDeflector(ctx context.Context, indexSetID string) error {
+	if indexSetID == "" {
+		return fmt.Errorf("index set ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/deflector/%s/cycle", indexSetID)
+
+	if err := c.Post(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to cycle deflector: %w", err)
+	}
+
+	return nil
+}
+
+// RebuildIndexRanges recalculates the index ranges of all indices of an index set
+func (c *Client) RebuildIndexRanges(ctx context.Context, indexSetID string) error {
+	if indexSetID == "" {
+		return fmt.Errorf("index set ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/indices/ranges/index_set/%s/rebuild", indexSetID)
+
+	if err := c.Post(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to rebuild index ranges: %w", err)
+	}
+
+	return nil
+}
+
+// RebuildAllIndexRanges recalculates the index ranges of all indices
+func (c *Client) RebuildAllIndexRanges(ctx context.Context) error {
+	endpoint := "system/indices/ranges/rebuild"
+
+	if err := c.Post(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to rebuild index ranges: %w", err)
+	}
+
+	return nil
+}
+
+// RebuildIndexRange recalculates the index range of a single index
+func (c *Client) RebuildIndexRange(ctx context.Context, index string) error {
+	if index == "" {
+		return fmt.Errorf("index name is required")
+	}
+
+	endpoint := fmt.Sprintf("system/indices/ranges/%s/rebuild", index)
+
+	if err := c.Post(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to rebuild index range: %w", err)
+	}
+
+	return nil
+}
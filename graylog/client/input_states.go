@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Input runtime states reported by Graylog nodes
+const (
+	InputStateRunning  = "RUNNING"
+	InputStateStarting = "STARTING"
+	InputStateFailed   = "FAILED"
+	InputStateFailing  = "FAILING"
+	InputStateStopped  = "STOPPED"
+)
+
+// InputState represents the runtime state of an input on a Graylog node
+type InputState struct {
+	ID              string `json:"id"`
+	State           string `json:"state"`
+	StartedAt       string `json:"started_at,omitempty"`
+	DetailedMessage string `json:"detailed_message,omitempty"`
+}
+
+// GetInputStates retrieves the runtime state of an input on every node it
+// is present on, keyed by node ID
+func (c *Client) GetInputStates(ctx context.Context, inputID string) (map[string]InputState, error) {
+	if inputID == "" {
+		return nil, fmt.Errorf("input ID is required")
+	}
+
+	endpoint := "cluster/inputstates"
+	var response map[string][]InputState
+
+	if err := c.Get(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to get input states: %w", err)
+	}
+
+	states := make(map[string]InputState)
+	for nodeID, nodeStates := range response {
+		for _, state := range nodeStates {
+			if state.ID == inputID {
+				states[nodeID] = state
+			}
+		}
+	}
+
+	return states, nil
+}
+
+// StartInput starts an input
+func (c *Client) StartInput(ctx context.Context, inputID string) error {
+	if inputID == "" {
+		return fmt.Errorf("input ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputstates/%s", inputID)
+
+	if err := c.Put(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to start input: %w", err)
+	}
+
+	return nil
+}
+
+// StopInput stops an input
+func (c *Client) StopInput(ctx context.Context, inputID string) error {
+	if inputID == "" {
+		return fmt.Errorf("input ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputstates/%s", inputID)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to stop input: %w", err)
+	}
+
+	return nil
+}
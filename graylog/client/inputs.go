@@ -1,7 +1,9 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -18,6 +20,7 @@ type Input struct {
 	Configuration map[string]interface{} `json:"configuration,omitempty"`
 	Node          string                 `json:"node,omitempty"`
 	ContentPack   string                 `json:"content_pack,omitempty"`
+	StaticFields  map[string]string      `json:"static_fields,omitempty"`
 }
 
 // InputsListResponse represents the response from listing inputs
@@ -27,7 +30,7 @@ type InputsListResponse struct {
 }
 
 // GetInput retrieves an input by ID
-func (c *Client) GetInput(id string) (*Input, error) {
+func (c *Client) GetInput(ctx context.Context, id string) (*Input, error) {
 	if id == "" {
 		return nil, fmt.Errorf("input ID is required")
 	}
@@ -35,7 +38,7 @@ func (c *Client) GetInput(id string) (*Input, error) {
 	endpoint := fmt.Sprintf("system/inputs/%s", id)
 	var input Input
 
-	if err := c.Get(endpoint, &input); err != nil {
+	if err := c.Get(ctx, endpoint, &input); err != nil {
 		return nil, fmt.Errorf("failed to get input: %w", err)
 	}
 
@@ -43,11 +46,11 @@ func (c *Client) GetInput(id string) (*Input, error) {
 }
 
 // ListInputs retrieves all inputs
-func (c *Client) ListInputs() ([]Input, error) {
+func (c *Client) ListInputs(ctx context.Context) ([]Input, error) {
 	endpoint := "system/inputs"
 	var response InputsListResponse
 
-	if err := c.Get(endpoint, &response); err != nil {
+	if err := c.Get(ctx, endpoint, &response); err != nil {
 		return nil, fmt.Errorf("failed to list inputs: %w", err)
 	}
 
@@ -55,8 +58,8 @@ func (c *Client) ListInputs() ([]Input, error) {
 }
 
 // SearchInputsByTitle searches for inputs by title
-func (c *Client) SearchInputsByTitle(title string) ([]Input, error) {
-	inputs, err := c.ListInputs()
+func (c *Client) SearchInputsByTitle(ctx context.Context, title string) ([]Input, error) {
+	inputs, err := c.ListInputs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +93,7 @@ type UpdateInputRequest struct {
 }
 
 // CreateInput creates a new input
-func (c *Client) CreateInput(req *CreateInputRequest) (*Input, error) {
+func (c *Client) CreateInput(ctx context.Context, req *CreateInputRequest) (*Input, error) {
 	if req == nil {
 		return nil, fmt.Errorf("create input request is required")
 	}
@@ -106,7 +109,7 @@ func (c *Client) CreateInput(req *CreateInputRequest) (*Input, error) {
 	endpoint := "system/inputs"
 	var response map[string]string
 
-	if err := c.Post(endpoint, req, &response); err != nil {
+	if err := c.Post(ctx, endpoint, req, &response); err != nil {
 		return nil, fmt.Errorf("failed to create input: %w", err)
 	}
 
@@ -117,11 +120,11 @@ func (c *Client) CreateInput(req *CreateInputRequest) (*Input, error) {
 	}
 
 	// Fetch the created input
-	return c.GetInput(inputID)
+	return c.GetInput(ctx, inputID)
 }
 
 // UpdateInput updates an existing input
-func (c *Client) UpdateInput(id string, req *UpdateInputRequest) (*Input, error) {
+func (c *Client) UpdateInput(ctx context.Context, id string, req *UpdateInputRequest) (*Input, error) {
 	if id == "" {
 		return nil, fmt.Errorf("input ID is required")
 	}
@@ -137,25 +140,118 @@ func (c *Client) UpdateInput(id string, req *UpdateInputRequest) (*Input, error)
 	endpoint := fmt.Sprintf("system/inputs/%s", id)
 	var input Input
 
-	if err := c.Put(endpoint, req, &input); err != nil {
+	if err := c.Put(ctx, endpoint, req, &input); err != nil {
 		return nil, fmt.Errorf("failed to update input: %w", err)
 	}
 
 	// Fetch the updated input to get complete state
-	return c.GetInput(id)
+	return c.GetInput(ctx, id)
 }
 
 // DeleteInput deletes an input by ID
-func (c *Client) DeleteInput(id string) error {
+func (c *Client) DeleteInput(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("input ID is required")
 	}
 
 	endpoint := fmt.Sprintf("system/inputs/%s", id)
 
-	if err := c.Delete(endpoint); err != nil {
+	if err := c.Delete(ctx, endpoint); err != nil {
 		return fmt.Errorf("failed to delete input: %w", err)
 	}
 
 	return nil
 }
+
+// InputType describes an input type and its configuration fields
+type InputType struct {
+	Type                   string                             `json:"type"`
+	Name                   string                             `json:"name"`
+	IsExclusive            bool                               `json:"is_exclusive"`
+	LinkToDocs             string                             `json:"link_to_docs,omitempty"`
+	RequestedConfiguration map[string]InputConfigurationField `json:"requested_configuration"`
+}
+
+// InputConfigurationField describes a configuration field of an input type
+type InputConfigurationField struct {
+	Type         string      `json:"type"`
+	HumanName    string      `json:"human_name"`
+	Description  string      `json:"description"`
+	DefaultValue interface{} `json:"default_value"`
+	IsOptional   bool        `json:"is_optional"`
+	IsEncrypted  bool        `json:"is_encrypted"`
+	Attributes   []string    `json:"attributes"`
+}
+
+// GetInputType retrieves the description of an input type
+func (c *Client) GetInputType(ctx context.Context, inputType string) (*InputType, error) {
+	if inputType == "" {
+		return nil, fmt.Errorf("input type is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/types/%s", inputType)
+	var result InputType
+
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, fmt.Errorf("failed to get input type: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListInputTypes retrieves the description of every input type available
+// in the cluster, keyed by type
+func (c *Client) ListInputTypes(ctx context.Context) (map[string]InputType, error) {
+	endpoint := "system/inputs/types/all"
+	var result map[string]InputType
+
+	if err := c.Get(ctx, endpoint, &result); err != nil {
+		return nil, fmt.Errorf("failed to list input types: %w", err)
+	}
+
+	return result, nil
+}
+
+// StaticFieldRequest represents the request to add a static field to an input
+type StaticFieldRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// AddInputStaticField adds a static field to the messages received by an input
+func (c *Client) AddInputStaticField(ctx context.Context, inputID string, req *StaticFieldRequest) error {
+	if inputID == "" {
+		return fmt.Errorf("input ID is required")
+	}
+
+	if req == nil || req.Key == "" {
+		return fmt.Errorf("static field key is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/%s/staticfields", inputID)
+
+	if err := c.Post(ctx, endpoint, req, nil); err != nil {
+		return fmt.Errorf("failed to add static field: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteInputStaticField removes a static field from an input
+func (c *Client) DeleteInputStaticField(ctx context.Context, inputID, key string) error {
+	if inputID == "" {
+		return fmt.Errorf("input ID is required")
+	}
+
+	if key == "" {
+		return fmt.Errorf("static field key is required")
+	}
+
+	endpoint := fmt.Sprintf("system/inputs/%s/staticfields/%s", inputID, url.PathEscape(key))
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete static field: %w", err)
+	}
+
+	return nil
+}
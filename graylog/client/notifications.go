@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -23,7 +24,7 @@ type EventNotificationsListResponse struct {
 }
 
 // GetEventNotification retrieves an event notification by ID
-func (c *Client) GetEventNotification(id string) (*EventNotification, error) {
+func (c *Client) GetEventNotification(ctx context.Context, id string) (*EventNotification, error) {
 	if id == "" {
 		return nil, fmt.Errorf("event notification ID is required")
 	}
@@ -31,28 +32,35 @@ func (c *Client) GetEventNotification(id string) (*EventNotification, error) {
 	endpoint := fmt.Sprintf("events/notifications/%s", id)
 	var notification EventNotification
 
-	if err := c.Get(endpoint, &notification); err != nil {
+	if err := c.Get(ctx, endpoint, &notification); err != nil {
 		return nil, fmt.Errorf("failed to get event notification: %w", err)
 	}
 
 	return &notification, nil
 }
 
-// ListEventNotifications retrieves all event notifications
-func (c *Client) ListEventNotifications() ([]EventNotification, error) {
-	endpoint := "events/notifications"
-	var response EventNotificationsListResponse
+// ListEventNotifications retrieves all event notifications, page by page
+func (c *Client) ListEventNotifications(ctx context.Context) ([]EventNotification, error) {
+	var notifications []EventNotification
 
-	if err := c.Get(endpoint, &response); err != nil {
-		return nil, fmt.Errorf("failed to list event notifications: %w", err)
-	}
+	for page := 1; ; page++ {
+		endpoint := fmt.Sprintf("events/notifications?page=%d&per_page=%d", page, listPageSize)
+		var response EventNotificationsListResponse
+
+		if err := c.Get(ctx, endpoint, &response); err != nil {
+			return nil, fmt.Errorf("failed to list event notifications: %w", err)
+		}
 
-	return response.Notifications, nil
+		notifications = append(notifications, response.Notifications...)
+		if len(response.Notifications) == 0 || len(notifications) >= response.Total {
+			return notifications, nil
+		}
+	}
 }
 
 // SearchEventNotificationsByTitle searches for event notifications by title
-func (c *Client) SearchEventNotificationsByTitle(title string) ([]EventNotification, error) {
-	notifications, err := c.ListEventNotifications()
+func (c *Client) SearchEventNotificationsByTitle(ctx context.Context, title string) ([]EventNotification, error) {
+	notifications, err := c.ListEventNotifications(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +97,7 @@ type UpdateEventNotificationRequest struct {
 }
 
 // CreateEventNotification creates a new event notification
-func (c *Client) CreateEventNotification(req *CreateEventNotificationRequest) (*EventNotification, error) {
+func (c *Client) CreateEventNotification(ctx context.Context, req *CreateEventNotificationRequest) (*EventNotification, error) {
 	if req == nil {
 		return nil, fmt.Errorf("create event notification request is required")
 	}
@@ -101,7 +109,7 @@ func (c *Client) CreateEventNotification(req *CreateEventNotificationRequest) (*
 	endpoint := "events/notifications"
 	var notification EventNotification
 
-	if err := c.Post(endpoint, req, &notification); err != nil {
+	if err := c.Post(ctx, endpoint, req, &notification); err != nil {
 		return nil, fmt.Errorf("failed to create event notification: %w", err)
 	}
 
@@ -109,7 +117,7 @@ func (c *Client) CreateEventNotification(req *CreateEventNotificationRequest) (*
 }
 
 // UpdateEventNotification updates an existing event notification
-func (c *Client) UpdateEventNotification(id string, req *UpdateEventNotificationRequest) (*EventNotification, error) {
+func (c *Client) UpdateEventNotification(ctx context.Context, id string, req *UpdateEventNotificationRequest) (*EventNotification, error) {
 	if id == "" {
 		return nil, fmt.Errorf("event notification ID is required")
 	}
@@ -125,7 +133,7 @@ func (c *Client) UpdateEventNotification(id string, req *UpdateEventNotification
 	endpoint := fmt.Sprintf("events/notifications/%s", id)
 	var notification EventNotification
 
-	if err := c.Put(endpoint, req, &notification); err != nil {
+	if err := c.Put(ctx, endpoint, req, &notification); err != nil {
 		return nil, fmt.Errorf("failed to update event notification: %w", err)
 	}
 
@@ -133,14 +141,14 @@ func (c *Client) UpdateEventNotification(id string, req *UpdateEventNotification
 }
 
 // DeleteEventNotification deletes an event notification by ID
-func (c *Client) DeleteEventNotification(id string) error {
+func (c *Client) DeleteEventNotification(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("event notification ID is required")
 	}
 
 	endpoint := fmt.Sprintf("events/notifications/%s", id)
 
-	if err := c.Delete(endpoint); err != nil {
+	if err := c.Delete(ctx, endpoint); err != nil {
 		return fmt.Errorf("failed to delete event notification: %w", err)
 	}
 
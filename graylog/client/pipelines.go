@@ -0,0 +1,330 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PipelineRule represents a Graylog pipeline processing rule
+type PipelineRule struct {
+	ID          string               `json:"id,omitempty"`
+	Title       string               `json:"title"`
+	Description string               `json:"description,omitempty"`
+	Source      string               `json:"source"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	ModifiedAt  string               `json:"modified_at,omitempty"`
+	Errors      []PipelineParseError `json:"errors,omitempty"`
+}
+
+// Pipeline represents a Graylog processing pipeline
+type Pipeline struct {
+	ID          string               `json:"id,omitempty"`
+	Title       string               `json:"title"`
+	Description string               `json:"description,omitempty"`
+	Source      string               `json:"source"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	ModifiedAt  string               `json:"modified_at,omitempty"`
+	Stages      []PipelineStage      `json:"stages,omitempty"`
+	Errors      []PipelineParseError `json:"errors,omitempty"`
+}
+
+// PipelineStage represents a stage of a processing pipeline
+type PipelineStage struct {
+	Stage int      `json:"stage"`
+	Match string   `json:"match,omitempty"`
+	Rules []string `json:"rules"`
+}
+
+// PipelineConnection represents the pipelines connected to a stream
+type PipelineConnection struct {
+	ID          string   `json:"id,omitempty"`
+	StreamID    string   `json:"stream_id"`
+	PipelineIDs []string `json:"pipeline_ids"`
+}
+
+// PipelineParseError represents a single error reported by the Graylog rule parser
+type PipelineParseError struct {
+	Type           string `json:"type"`
+	Line           int    `json:"line"`
+	PositionInLine int    `json:"position_in_line"`
+	Reason         string `json:"reason,omitempty"`
+	Message        string `json:"message,omitempty"`
+}
+
+// String returns a human readable description of the parse error
+func (e PipelineParseError) String() string {
+	reason := e.Reason
+	if reason == "" {
+		reason = e.Message
+	}
+	if reason == "" {
+		reason = e.Type
+	}
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.PositionInLine, reason)
+}
+
+// PipelineSourceError is returned when Graylog rejects rule or pipeline source code
+type PipelineSourceError struct {
+	Errors []PipelineParseError
+}
+
+// Error implements the error interface
+func (e *PipelineSourceError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, parseErr := range e.Errors {
+		messages = append(messages, parseErr.String())
+	}
+	return "invalid source: " + strings.Join(messages, "; ")
+}
+
+// pipelineSourceError converts a bad request carrying parser errors into a PipelineSourceError
+func pipelineSourceError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		return err
+	}
+
+	var parseErrors []PipelineParseError
+	if jsonErr := json.Unmarshal([]byte(apiErr.Body), &parseErrors); jsonErr != nil || len(parseErrors) == 0 {
+		return err
+	}
+
+	return &PipelineSourceError{Errors: parseErrors}
+}
+
+// PipelineSourceRequest represents the request to create or update a rule or pipeline
+type PipelineSourceRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Source      string `json:"source"`
+}
+
+// GetPipelineRule retrieves a pipeline rule by ID
+func (c *Client) GetPipelineRule(ctx context.Context, id string) (*PipelineRule, error) {
+	if id == "" {
+		return nil, fmt.Errorf("pipeline rule ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/rule/%s", id)
+	var rule PipelineRule
+
+	if err := c.Get(ctx, endpoint, &rule); err != nil {
+		return nil, fmt.Errorf("failed to get pipeline rule: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// ListPipelineRules retrieves all pipeline rules
+func (c *Client) ListPipelineRules(ctx context.Context) ([]PipelineRule, error) {
+	endpoint := "system/pipelines/rule"
+	var rules []PipelineRule
+
+	if err := c.Get(ctx, endpoint, &rules); err != nil {
+		return nil, fmt.Errorf("failed to list pipeline rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// CreatePipelineRule creates a new pipeline rule
+func (c *Client) CreatePipelineRule(ctx context.Context, req *PipelineSourceRequest) (*PipelineRule, error) {
+	if req == nil {
+		return nil, fmt.Errorf("create pipeline rule request is required")
+	}
+
+	if req.Source == "" {
+		return nil, fmt.Errorf("pipeline rule source is required")
+	}
+
+	endpoint := "system/pipelines/rule"
+	var rule PipelineRule
+
+	if err := c.Post(ctx, endpoint, req, &rule); err != nil {
+		return nil, fmt.Errorf("failed to create pipeline rule: %w", pipelineSourceError(err))
+	}
+
+	return &rule, nil
+}
+
+// UpdatePipelineRule updates an existing pipeline rule
+func (c *Client) UpdatePipelineRule(ctx context.Context, id string, req *PipelineSourceRequest) (*PipelineRule, error) {
+	if id == "" {
+		return nil, fmt.Errorf("pipeline rule ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("update pipeline rule request is required")
+	}
+
+	if req.Source == "" {
+		return nil, fmt.Errorf("pipeline rule source is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/rule/%s", id)
+	var rule PipelineRule
+
+	if err := c.Put(ctx, endpoint, req, &rule); err != nil {
+		return nil, fmt.Errorf("failed to update pipeline rule: %w", pipelineSourceError(err))
+	}
+
+	return &rule, nil
+}
+
+// DeletePipelineRule deletes a pipeline rule by ID
+func (c *Client) DeletePipelineRule(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("pipeline rule ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/rule/%s", id)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete pipeline rule: %w", err)
+	}
+
+	return nil
+}
+
+// GetPipeline retrieves a pipeline by ID
+func (c *Client) GetPipeline(ctx context.Context, id string) (*Pipeline, error) {
+	if id == "" {
+		return nil, fmt.Errorf("pipeline ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/pipeline/%s", id)
+	var pipeline Pipeline
+
+	if err := c.Get(ctx, endpoint, &pipeline); err != nil {
+		return nil, fmt.Errorf("failed to get pipeline: %w", err)
+	}
+
+	return &pipeline, nil
+}
+
+// ListPipelines retrieves all pipelines
+func (c *Client) ListPipelines(ctx context.Context) ([]Pipeline, error) {
+	endpoint := "system/pipelines/pipeline"
+	var pipelines []Pipeline
+
+	if err := c.Get(ctx, endpoint, &pipelines); err != nil {
+		return nil, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+
+	return pipelines, nil
+}
+
+// CreatePipeline creates a new pipeline
+func (c *Client) CreatePipeline(ctx context.Context, req *PipelineSourceRequest) (*Pipeline, error) {
+	if req == nil {
+		return nil, fmt.Errorf("create pipeline request is required")
+	}
+
+	if req.Source == "" {
+		return nil, fmt.Errorf("pipeline source is required")
+	}
+
+	endpoint := "system/pipelines/pipeline"
+	var pipeline Pipeline
+
+	if err := c.Post(ctx, endpoint, req, &pipeline); err != nil {
+		return nil, fmt.Errorf("failed to create pipeline: %w", pipelineSourceError(err))
+	}
+
+	return &pipeline, nil
+}
+
+// UpdatePipeline updates an existing pipeline
+func (c *Client) UpdatePipeline(ctx context.Context, id string, req *PipelineSourceRequest) (*Pipeline, error) {
+	if id == "" {
+		return nil, fmt.Errorf("pipeline ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("update pipeline request is required")
+	}
+
+	if req.Source == "" {
+		return nil, fmt.Errorf("pipeline source is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/pipeline/%s", id)
+	var pipeline Pipeline
+
+	if err := c.Put(ctx, endpoint, req, &pipeline); err != nil {
+		return nil, fmt.Errorf("failed to update pipeline: %w", pipelineSourceError(err))
+	}
+
+	return &pipeline, nil
+}
+
+// DeletePipeline deletes a pipeline by ID
+func (c *Client) DeletePipeline(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("pipeline ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/pipeline/%s", id)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete pipeline: %w", err)
+	}
+
+	return nil
+}
+
+// ListPipelineConnections retrieves the pipeline connections of all streams
+func (c *Client) ListPipelineConnections(ctx context.Context) ([]PipelineConnection, error) {
+	endpoint := "system/pipelines/connections"
+	var connections []PipelineConnection
+
+	if err := c.Get(ctx, endpoint, &connections); err != nil {
+		return nil, fmt.Errorf("failed to list pipeline connections: %w", err)
+	}
+
+	return connections, nil
+}
+
+// GetPipelineConnection retrieves the pipelines connected to a stream
+func (c *Client) GetPipelineConnection(ctx context.Context, streamID string) (*PipelineConnection, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	endpoint := fmt.Sprintf("system/pipelines/connections/%s", streamID)
+	var connection PipelineConnection
+
+	if err := c.Get(ctx, endpoint, &connection); err != nil {
+		return nil, fmt.Errorf("failed to get pipeline connection: %w", err)
+	}
+
+	return &connection, nil
+}
+
+// ConnectPipelinesToStream replaces the set of pipelines connected to a stream
+func (c *Client) ConnectPipelinesToStream(ctx context.Context, streamID string, pipelineIDs []string) (*PipelineConnection, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	if pipelineIDs == nil {
+		pipelineIDs = []string{}
+	}
+
+	endpoint := "system/pipelines/connections/to_stream"
+	req := &PipelineConnection{
+		StreamID:    streamID,
+		PipelineIDs: pipelineIDs,
+	}
+	var connection PipelineConnection
+
+	if err := c.Post(ctx, endpoint, req, &connection); err != nil {
+		return nil, fmt.Errorf("failed to connect pipelines to stream: %w", err)
+	}
+
+	return &connection, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings, used unless overridden with SetRetry
+const (
+	DefaultRetryMaxAttempts = 5
+	DefaultRetryMaxDuration = 2 * time.Minute
+)
+
+// Bounds of the exponential backoff between two attempts, variables so that
+// tests can shorten them
+var (
+	retryMinBackoff = 500 * time.Millisecond
+	retryMaxBackoff = 30 * time.Second
+)
+
+// SetRetry sets the maximum number of attempts of a request and the maximum
+// time spent retrying it. A maxAttempts of 1 disables retries.
+func (c *Client) SetRetry(maxAttempts int, maxDuration time.Duration) {
+	c.RetryMaxAttempts = maxAttempts
+	c.RetryMaxDuration = maxDuration
+}
+
+// isIdempotent reports whether a request with the given method can safely be
+// sent again after the server may have processed it
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status signals a transient
+// condition, e.g. a leader change or a rolling restart behind a load balancer
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableError reports whether a request error can be retried. Failures
+// to connect are retried for every method as the request never reached the
+// server, other connection errors only for idempotent methods. Failed logins
+// are retried on transient statuses.
+func isRetryableError(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableStatus(apiErr.StatusCode)
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return idempotent
+}
+
+// backoff returns the jittered wait before the given retry, starting at 1
+func backoff(retry int) time.Duration {
+	wait := retryMaxBackoff
+	if retry < 16 {
+		wait = min(retryMinBackoff<<(retry-1), retryMaxBackoff)
+	}
+
+	// Equal jitter keeps at least half of the backoff
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// retryAfter parses the Retry-After header of a response, given either in
+// seconds or as an HTTP date. It returns 0 when the header is missing.
+func retryAfter(resp *http.Response, now time.Time) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
+
+// sleep waits for the given duration or until the context is done
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,360 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestClient creates a client for the given server URL with short
+// backoffs
+func newRetryTestClient(t *testing.T, baseURL string) *Client {
+	t.Helper()
+
+	minBackoff, maxBackoff := retryMinBackoff, retryMaxBackoff
+	retryMinBackoff, retryMaxBackoff = time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() {
+		retryMinBackoff, retryMaxBackoff = minBackoff, maxBackoff
+	})
+
+	username := "admin"
+	password := "password"
+	client, err := NewClient(&baseURL, &username, &password)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// failingServer starts a server failing the first failures requests with the
+// given status and counting all requests
+func failingServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"type":"ApiError","message":"unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+// TestRetryStatus tests the retries of requests failing with a status code
+func TestRetryStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		status           int
+		failures         int32
+		expectRequests   int32
+		expectError      bool
+		expectStatusCode int
+	}{
+		{
+			name:           "GET recovers from 503",
+			method:         http.MethodGet,
+			status:         http.StatusServiceUnavailable,
+			failures:       2,
+			expectRequests: 3,
+		},
+		{
+			name:           "PUT recovers from 502",
+			method:         http.MethodPut,
+			status:         http.StatusBadGateway,
+			failures:       1,
+			expectRequests: 2,
+		},
+		{
+			name:           "DELETE recovers from 504",
+			method:         http.MethodDelete,
+			status:         http.StatusGatewayTimeout,
+			failures:       4,
+			expectRequests: 5,
+		},
+		{
+			name:             "GET gives up after max attempts",
+			method:           http.MethodGet,
+			status:           http.StatusServiceUnavailable,
+			failures:         10,
+			expectRequests:   DefaultRetryMaxAttempts,
+			expectError:      true,
+			expectStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:             "POST is not retried",
+			method:           http.MethodPost,
+			status:           http.StatusServiceUnavailable,
+			failures:         1,
+			expectRequests:   1,
+			expectError:      true,
+			expectStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:             "GET is not retried on 500",
+			method:           http.MethodGet,
+			status:           http.StatusInternalServerError,
+			failures:         1,
+			expectRequests:   1,
+			expectError:      true,
+			expectStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:             "GET is not retried on 404",
+			method:           http.MethodGet,
+			status:           http.StatusNotFound,
+			failures:         1,
+			expectRequests:   1,
+			expectError:      true,
+			expectStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := failingServer(t, tt.failures, tt.status, nil)
+			client := newRetryTestClient(t, server.URL)
+
+			resp, err := client.doRequest(context.Background(), tt.method, "system", map[string]string{"title": "test"})
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			if got := requests.Load(); got != tt.expectRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectRequests, got)
+			}
+
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if tt.expectError {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("Expected APIError, got %v", err)
+				}
+				if apiErr.StatusCode != tt.expectStatusCode {
+					t.Errorf("Expected status code %d, got %d", tt.expectStatusCode, apiErr.StatusCode)
+				}
+			}
+		})
+	}
+}
+
+// TestRetryRequestBody tests that the request body is sent again on retries
+func TestRetryRequestBody(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || string(body) != `{"title":"test"}` {
+			t.Errorf("Unexpected request body %q (%v)", body, err)
+		}
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL)
+	if err := client.Put(context.Background(), "system", map[string]string{"title": "test"}, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+// TestRetryAfter tests that the Retry-After header is honored
+func TestRetryAfter(t *testing.T) {
+	t.Run("Waits for Retry-After", func(t *testing.T) {
+		server, requests := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+		client := newRetryTestClient(t, server.URL)
+
+		start := time.Now()
+		if err := client.Get(context.Background(), "system", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if elapsed := time.Since(start); elapsed < time.Second {
+			t.Errorf("Expected to wait at least 1s, waited %s", elapsed)
+		}
+		if got := requests.Load(); got != 2 {
+			t.Errorf("Expected 2 requests, got %d", got)
+		}
+	})
+
+	t.Run("Gives up when Retry-After exceeds max duration", func(t *testing.T) {
+		server, requests := failingServer(t, 1, http.StatusServiceUnavailable, http.Header{"Retry-After": {"120"}})
+		client := newRetryTestClient(t, server.URL)
+		client.SetRetry(DefaultRetryMaxAttempts, time.Minute)
+
+		start := time.Now()
+		if err := client.Get(context.Background(), "system", nil); err == nil {
+			t.Fatalf("Expected error but got none")
+		}
+
+		if elapsed := time.Since(start); elapsed > 10*time.Second {
+			t.Errorf("Expected to give up immediately, waited %s", elapsed)
+		}
+		if got := requests.Load(); got != 1 {
+			t.Errorf("Expected 1 request, got %d", got)
+		}
+	})
+}
+
+// TestRetryAfterParsing tests the parsing of the Retry-After header
+func TestRetryAfterParsing(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		expect time.Duration
+	}{
+		{name: "Missing", value: "", expect: 0},
+		{name: "Seconds", value: "3", expect: 3 * time.Second},
+		{name: "Negative seconds", value: "-3", expect: 0},
+		{name: "HTTP date", value: now.Add(90 * time.Second).Format(http.TimeFormat), expect: 90 * time.Second},
+		{name: "HTTP date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), expect: 0},
+		{name: "Invalid", value: "soon", expect: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.value != "" {
+				resp.Header.Set("Retry-After", tt.value)
+			}
+
+			if got := retryAfter(resp, now); got != tt.expect {
+				t.Errorf("Expected %s, got %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+// TestRetryConnectionErrors tests the retries of requests failing before a
+// response is received
+func TestRetryConnectionErrors(t *testing.T) {
+	t.Run("Retries dial errors for every method", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		client := newRetryTestClient(t, server.URL)
+		client.SetRetry(3, time.Minute)
+
+		err := client.Post(context.Background(), "system", nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "giving up after 3 attempts") {
+			t.Errorf("Expected error after 3 attempts, got %v", err)
+		}
+	})
+
+	// Closing the connection without a response fails the request after it was sent
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("Failed to hijack connection: %v", err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	t.Run("Retries dropped connections for GET", func(t *testing.T) {
+		requests.Store(0)
+		client := newRetryTestClient(t, server.URL)
+
+		if err := client.Get(context.Background(), "system", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := requests.Load(); got != 2 {
+			t.Errorf("Expected 2 requests, got %d", got)
+		}
+	})
+
+	t.Run("Does not retry dropped connections for POST", func(t *testing.T) {
+		requests.Store(0)
+		client := newRetryTestClient(t, server.URL)
+
+		if err := client.Post(context.Background(), "system", nil, nil); err == nil {
+			t.Fatalf("Expected error but got none")
+		}
+		if got := requests.Load(); got != 1 {
+			t.Errorf("Expected 1 request, got %d", got)
+		}
+	})
+}
+
+// TestRetryContextCancellation tests that retries stop when the context is done
+func TestRetryContextCancellation(t *testing.T) {
+	server, requests := failingServer(t, 10, http.StatusServiceUnavailable, http.Header{"Retry-After": {"5"}})
+	client := newRetryTestClient(t, server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Get(ctx, "system", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline error, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected to stop on context deadline, waited %s", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+// TestRetryDisabled tests that a single attempt is made when retries are disabled
+func TestRetryDisabled(t *testing.T) {
+	server, requests := failingServer(t, 1, http.StatusServiceUnavailable, nil)
+	client := newRetryTestClient(t, server.URL)
+	client.SetRetry(1, time.Minute)
+
+	if err := client.Get(context.Background(), "system", nil); err == nil {
+		t.Fatalf("Expected error but got none")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+// TestBackoff tests the bounds of the jittered exponential backoff
+func TestBackoff(t *testing.T) {
+	for retry := 1; retry <= 20; retry++ {
+		wait := backoff(retry)
+		ceiling := min(retryMinBackoff<<min(retry-1, 15), retryMaxBackoff)
+
+		if wait < ceiling/2 || wait > ceiling {
+			t.Errorf("Retry %d: expected backoff between %s and %s, got %s", retry, ceiling/2, ceiling, wait)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateSessionRequest represents the request to log in
+type CreateSessionRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Host     string `json:"host"`
+}
+
+// Session represents a Graylog session
+type Session struct {
+	SessionID  string `json:"session_id"`
+	ValidUntil string `json:"valid_until"`
+	Username   string `json:"username,omitempty"`
+	UserID     string `json:"user_id,omitempty"`
+}
+
+// session returns the current session ID, logging in when there is none
+func (c *Client) session(ctx context.Context) (string, error) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	if c.sessionID == "" {
+		session, err := c.createSession(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.sessionID = session.SessionID
+	}
+
+	return c.sessionID, nil
+}
+
+// invalidateSession forgets the given session so that the next request logs
+// in again, unless another request already replaced it
+func (c *Client) invalidateSession(sessionID string) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	if c.sessionID == sessionID {
+		c.sessionID = ""
+	}
+}
+
+// createSession logs in with the username and password of the client
+func (c *Client) createSession(ctx context.Context) (*Session, error) {
+	jsonData, err := json.Marshal(&CreateSessionRequest{
+		Username: c.Username,
+		Password: c.Password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/system/sessions", c.BaseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, url, jsonData)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to create session: %w", newAPIError(resp))
+	}
+
+	var session Session
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if session.SessionID == "" {
+		return nil, fmt.Errorf("session creation did not return an ID")
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// sessionServer starts a server issuing numbered sessions and accepting only
+// the latest one
+func sessionServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var logins atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/system/sessions" {
+			var req CreateSessionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Username != "admin" || req.Password != "password" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprintf(w, `{"session_id":"session-%d","valid_until":"2030-01-01T00:00:00.000Z"}`, logins.Add(1))
+			return
+		}
+
+		sessionID, password, _ := r.BasicAuth()
+		if password != "session" || sessionID != fmt.Sprintf("session-%d", logins.Load()) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &logins
+}
+
+// TestSessionAuthentication tests that the client logs in once and renews
+// expired sessions
+func TestSessionAuthentication(t *testing.T) {
+	server, logins := sessionServer(t)
+
+	baseURL := server.URL
+	username := "admin"
+	password := "password"
+	client, err := NewClientWithSession(&baseURL, &username, &password)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if err := client.Get(ctx, "system", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if got := logins.Load(); got != 1 {
+		t.Errorf("Expected 1 login, got %d", got)
+	}
+
+	// Expire the session on the server side, the next login issues session-3
+	logins.Add(1)
+
+	if err := client.Post(ctx, "system", nil, nil); err != nil {
+		t.Fatalf("Unexpected error after session expiry: %v", err)
+	}
+	if got := logins.Load(); got != 3 {
+		t.Errorf("Expected session-3, got session-%d", got)
+	}
+}
+
+// TestSessionLoginFailure tests that failed logins are reported
+func TestSessionLoginFailure(t *testing.T) {
+	server, _ := sessionServer(t)
+
+	baseURL := server.URL
+	username := "admin"
+	password := "wrong"
+	client, err := NewClientWithSession(&baseURL, &username, &password)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Get(context.Background(), "system", nil)
+	if err == nil {
+		t.Fatalf("Expected error but got none")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected 401 APIError, got %v", err)
+	}
+}
@@ -0,0 +1,363 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Stream rule types as understood by the Graylog API
+const (
+	StreamRuleTypeExact       = 1
+	StreamRuleTypeRegex       = 2
+	StreamRuleTypeGreater     = 3
+	StreamRuleTypeSmaller     = 4
+	StreamRuleTypePresence    = 5
+	StreamRuleTypeContains    = 6
+	StreamRuleTypeAlwaysMatch = 7
+	StreamRuleTypeMatchInput  = 8
+)
+
+// StreamRuleTypeNames maps the human readable stream rule type names to their API values
+var StreamRuleTypeNames = map[string]int{
+	"exact":        StreamRuleTypeExact,
+	"regex":        StreamRuleTypeRegex,
+	"greater":      StreamRuleTypeGreater,
+	"smaller":      StreamRuleTypeSmaller,
+	"presence":     StreamRuleTypePresence,
+	"contains":     StreamRuleTypeContains,
+	"always_match": StreamRuleTypeAlwaysMatch,
+	"match_input":  StreamRuleTypeMatchInput,
+}
+
+// StreamRuleTypeName returns the human readable name of a stream rule type
+func StreamRuleTypeName(ruleType int) (string, bool) {
+	for name, value := range StreamRuleTypeNames {
+		if value == ruleType {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Stream represents a Graylog stream
+type Stream struct {
+	ID                             string       `json:"id,omitempty"`
+	Title                          string       `json:"title"`
+	Description                    string       `json:"description,omitempty"`
+	Rules                          []StreamRule `json:"rules,omitempty"`
+	MatchingType                   string       `json:"matching_type,omitempty"`
+	RemoveMatchesFromDefaultStream bool         `json:"remove_matches_from_default_stream"`
+	IndexSetID                     string       `json:"index_set_id,omitempty"`
+	Disabled                       bool         `json:"disabled"`
+	IsDefault                      bool         `json:"is_default,omitempty"`
+	IsEditable                     bool         `json:"is_editable,omitempty"`
+	CreatorUserID                  string       `json:"creator_user_id,omitempty"`
+	CreatedAt                      string       `json:"created_at,omitempty"`
+	ContentPack                    string       `json:"content_pack,omitempty"`
+}
+
+// StreamRule represents a rule attached to a Graylog stream
+type StreamRule struct {
+	ID          string `json:"id,omitempty"`
+	StreamID    string `json:"stream_id,omitempty"`
+	Field       string `json:"field"`
+	Type        int    `json:"type"`
+	Value       string `json:"value"`
+	Inverted    bool   `json:"inverted"`
+	Description string `json:"description,omitempty"`
+}
+
+// StreamsListResponse represents the response from listing streams
+type StreamsListResponse struct {
+	Total   int      `json:"total"`
+	Streams []Stream `json:"streams"`
+}
+
+// StreamRulesListResponse represents the response from listing stream rules
+type StreamRulesListResponse struct {
+	Total       int          `json:"total"`
+	StreamRules []StreamRule `json:"stream_rules"`
+}
+
+// GetStream retrieves a stream by ID
+func (c *Client) GetStream(ctx context.Context, id string) (*Stream, error) {
+	if id == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s", id)
+	var stream Stream
+
+	if err := c.Get(ctx, endpoint, &stream); err != nil {
+		return nil, fmt.Errorf("failed to get stream: %w", err)
+	}
+
+	return &stream, nil
+}
+
+// ListStreams retrieves all streams
+func (c *Client) ListStreams(ctx context.Context) ([]Stream, error) {
+	endpoint := "streams"
+	var response StreamsListResponse
+
+	if err := c.Get(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to list streams: %w", err)
+	}
+
+	return response.Streams, nil
+}
+
+// SearchStreamsByTitle searches for streams by title
+func (c *Client) SearchStreamsByTitle(ctx context.Context, title string) ([]Stream, error) {
+	streams, err := c.ListStreams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []Stream
+	for _, stream := range streams {
+		if stream.Title == title {
+			filtered = append(filtered, stream)
+		}
+	}
+
+	return filtered, nil
+}
+
+// CreateStreamRequest represents the request to create a stream
+type CreateStreamRequest struct {
+	Title                          string                    `json:"title"`
+	Description                    string                    `json:"description,omitempty"`
+	Rules                          []CreateStreamRuleRequest `json:"rules"`
+	MatchingType                   string                    `json:"matching_type,omitempty"`
+	RemoveMatchesFromDefaultStream bool                      `json:"remove_matches_from_default_stream"`
+	IndexSetID                     string                    `json:"index_set_id"`
+}
+
+// UpdateStreamRequest represents the request to update a stream
+type UpdateStreamRequest struct {
+	Title                          string `json:"title"`
+	Description                    string `json:"description"`
+	MatchingType                   string `json:"matching_type,omitempty"`
+	RemoveMatchesFromDefaultStream bool   `json:"remove_matches_from_default_stream"`
+	IndexSetID                     string `json:"index_set_id"`
+}
+
+// CreateStream creates a new stream. Graylog creates streams in a paused
+// state, use ResumeStream to start routing messages into it.
+func (c *Client) CreateStream(ctx context.Context, req *CreateStreamRequest) (*Stream, error) {
+	if req == nil {
+		return nil, fmt.Errorf("create stream request is required")
+	}
+
+	if req.Title == "" {
+		return nil, fmt.Errorf("stream title is required")
+	}
+
+	if req.IndexSetID == "" {
+		return nil, fmt.Errorf("stream index set ID is required")
+	}
+
+	endpoint := "streams"
+	var response map[string]string
+
+	if err := c.Post(ctx, endpoint, req, &response); err != nil {
+		return nil, fmt.Errorf("failed to create stream: %w", err)
+	}
+
+	// Get the created stream ID from response
+	streamID, ok := response["stream_id"]
+	if !ok {
+		return nil, fmt.Errorf("stream creation did not return an ID")
+	}
+
+	// Fetch the created stream
+	return c.GetStream(ctx, streamID)
+}
+
+// UpdateStream updates an existing stream
+func (c *Client) UpdateStream(ctx context.Context, id string, req *UpdateStreamRequest) (*Stream, error) {
+	if id == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("update stream request is required")
+	}
+
+	if req.Title == "" {
+		return nil, fmt.Errorf("stream title is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s", id)
+
+	if err := c.Put(ctx, endpoint, req, nil); err != nil {
+		return nil, fmt.Errorf("failed to update stream: %w", err)
+	}
+
+	// Fetch the updated stream to get complete state
+	return c.GetStream(ctx, id)
+}
+
+// DeleteStream deletes a stream by ID
+func (c *Client) DeleteStream(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("stream ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s", id)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete stream: %w", err)
+	}
+
+	return nil
+}
+
+// PauseStream stops routing messages into a stream
+func (c *Client) PauseStream(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("stream ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/pause", id)
+
+	if err := c.Post(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to pause stream: %w", err)
+	}
+
+	return nil
+}
+
+// ResumeStream starts routing messages into a paused stream
+func (c *Client) ResumeStream(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("stream ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/resume", id)
+
+	if err := c.Post(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to resume stream: %w", err)
+	}
+
+	return nil
+}
+
+// CreateStreamRuleRequest represents the request to create or update a stream rule
+type CreateStreamRuleRequest struct {
+	Field       string `json:"field"`
+	Type        int    `json:"type"`
+	Value       string `json:"value"`
+	Inverted    bool   `json:"inverted"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateStreamRuleRequest represents the request to update a stream rule
+type UpdateStreamRuleRequest = CreateStreamRuleRequest
+
+// GetStreamRule retrieves a single rule of a stream
+func (c *Client) GetStreamRule(ctx context.Context, streamID, ruleID string) (*StreamRule, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	if ruleID == "" {
+		return nil, fmt.Errorf("stream rule ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/rules/%s", streamID, ruleID)
+	var rule StreamRule
+
+	if err := c.Get(ctx, endpoint, &rule); err != nil {
+		return nil, fmt.Errorf("failed to get stream rule: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// ListStreamRules retrieves all rules of a stream
+func (c *Client) ListStreamRules(ctx context.Context, streamID string) ([]StreamRule, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/rules", streamID)
+	var response StreamRulesListResponse
+
+	if err := c.Get(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to list stream rules: %w", err)
+	}
+
+	return response.StreamRules, nil
+}
+
+// CreateStreamRule adds a new rule to a stream
+func (c *Client) CreateStreamRule(ctx context.Context, streamID string, req *CreateStreamRuleRequest) (*StreamRule, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("create stream rule request is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/rules", streamID)
+	var response map[string]string
+
+	if err := c.Post(ctx, endpoint, req, &response); err != nil {
+		return nil, fmt.Errorf("failed to create stream rule: %w", err)
+	}
+
+	// Get the created rule ID from response
+	ruleID, ok := response["streamrule_id"]
+	if !ok {
+		return nil, fmt.Errorf("stream rule creation did not return an ID")
+	}
+
+	// Fetch the created rule
+	return c.GetStreamRule(ctx, streamID, ruleID)
+}
+
+// UpdateStreamRule updates an existing rule of a stream
+func (c *Client) UpdateStreamRule(ctx context.Context, streamID, ruleID string, req *UpdateStreamRuleRequest) (*StreamRule, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("stream ID is required")
+	}
+
+	if ruleID == "" {
+		return nil, fmt.Errorf("stream rule ID is required")
+	}
+
+	if req == nil {
+		return nil, fmt.Errorf("update stream rule request is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/rules/%s", streamID, ruleID)
+
+	if err := c.Put(ctx, endpoint, req, nil); err != nil {
+		return nil, fmt.Errorf("failed to update stream rule: %w", err)
+	}
+
+	// Fetch the updated rule to get complete state
+	return c.GetStreamRule(ctx, streamID, ruleID)
+}
+
+// DeleteStreamRule deletes a rule from a stream
+func (c *Client) DeleteStreamRule(ctx context.Context, streamID, ruleID string) error {
+	if streamID == "" {
+		return fmt.Errorf("stream ID is required")
+	}
+
+	if ruleID == "" {
+		return fmt.Errorf("stream rule ID is required")
+	}
+
+	endpoint := fmt.Sprintf("streams/%s/rules/%s", streamID, ruleID)
+
+	if err := c.Delete(ctx, endpoint); err != nil {
+		return fmt.Errorf("failed to delete stream rule: %w", err)
+	}
+
+	return nil
+}
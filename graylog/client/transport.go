@@ -0,0 +1,100 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// TransportConfig holds the TLS and proxy settings of the client transport
+type TransportConfig struct {
+	// CACertPEM and CACertFile are PEM encoded CA certificates trusted in
+	// addition to the system certificate pool
+	CACertPEM  string
+	CACertFile string
+	// ClientCertPEM and ClientKeyPEM are the PEM encoded certificate and key
+	// presented to servers requiring mutual TLS
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// ServerName overrides the host name used to verify the server certificate
+	ServerName         string
+	InsecureSkipVerify bool
+	// ProxyURL is the HTTP(S) proxy used for all requests. When empty, the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.
+	ProxyURL string
+}
+
+// NewTransport creates an HTTP transport with the given TLS and proxy settings
+func NewTransport(config *TransportConfig) (*http.Transport, error) {
+	if config == nil {
+		return nil, fmt.Errorf("transport config is required")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+			return nil, fmt.Errorf("invalid proxy URL %q: the scheme must be http or https", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertPEM != "" || config.CACertFile != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if config.CACertPEM != "" && !rootCAs.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("no valid certificate found in CA certificate PEM")
+		}
+
+		if config.CACertFile != "" {
+			caCert, err := os.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+			}
+			if !rootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid certificate found in CA certificate file %s", config.CACertFile)
+			}
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		if config.ClientCertPEM == "" || config.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client certificate and client key must be set together")
+		}
+
+		clientCert, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// SetTransport sets the transport used to send requests, e.g. one created
+// with NewTransport
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.HTTPClient.Transport = transport
+}
@@ -0,0 +1,262 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTransportTestClient creates a client for the given server URL using a
+// transport created from config
+func newTransportTestClient(t *testing.T, baseURL string, config *TransportConfig) *Client {
+	t.Helper()
+
+	username := "admin"
+	password := "password"
+	client, err := NewClient(&baseURL, &username, &password)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.SetRetry(1, time.Minute)
+
+	transport, err := NewTransport(config)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	client.SetTransport(transport)
+
+	return client
+}
+
+// certificatePEM returns the PEM encoding of a DER certificate
+func certificatePEM(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// newClientCertificate creates a self-signed client certificate and returns
+// its certificate and key PEM encoded
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	return cert, certificatePEM(der), keyPEM
+}
+
+// TestTransportTLS tests the verification of the server certificate
+func TestTransportTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	caCertPEM := certificatePEM(server.Certificate().Raw)
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCertPEM), 0o600); err != nil {
+		t.Fatalf("Failed to write CA certificate file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		config      *TransportConfig
+		expectError bool
+	}{
+		{
+			name:        "Untrusted server certificate",
+			config:      &TransportConfig{},
+			expectError: true,
+		},
+		{
+			name:        "CA certificate PEM",
+			config:      &TransportConfig{CACertPEM: caCertPEM},
+			expectError: false,
+		},
+		{
+			name:        "CA certificate file",
+			config:      &TransportConfig{CACertFile: caCertFile},
+			expectError: false,
+		},
+		{
+			name:        "Matching server name",
+			config:      &TransportConfig{CACertPEM: caCertPEM, ServerName: "example.com"},
+			expectError: false,
+		},
+		{
+			name:        "Mismatching server name",
+			config:      &TransportConfig{CACertPEM: caCertPEM, ServerName: "graylog.internal"},
+			expectError: true,
+		},
+		{
+			name:        "Insecure skip verify",
+			config:      &TransportConfig{InsecureSkipVerify: true},
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTransportTestClient(t, server.URL, tt.config)
+			err := client.Get(context.Background(), "system", nil)
+
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestTransportClientCertificate tests mutual TLS
+func TestTransportClientCertificate(t *testing.T) {
+	clientCert, clientCertPEM, clientKeyPEM := newClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caCertPEM := certificatePEM(server.Certificate().Raw)
+
+	t.Run("Without client certificate", func(t *testing.T) {
+		client := newTransportTestClient(t, server.URL, &TransportConfig{CACertPEM: caCertPEM})
+		if err := client.Get(context.Background(), "system", nil); err == nil {
+			t.Errorf("Expected error but got none")
+		}
+	})
+
+	t.Run("With client certificate", func(t *testing.T) {
+		client := newTransportTestClient(t, server.URL, &TransportConfig{
+			CACertPEM:     caCertPEM,
+			ClientCertPEM: clientCertPEM,
+			ClientKeyPEM:  clientKeyPEM,
+		})
+		if err := client.Get(context.Background(), "system", nil); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+// TestTransportProxy tests that requests are sent through the proxy
+func TestTransportProxy(t *testing.T) {
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+
+	client := newTransportTestClient(t, "http://graylog.invalid", &TransportConfig{ProxyURL: proxy.URL})
+	if err := client.Get(context.Background(), "system", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if proxiedURL != "http://graylog.invalid/api/system" {
+		t.Errorf("Expected proxied URL http://graylog.invalid/api/system, got %s", proxiedURL)
+	}
+}
+
+// TestNewTransportValidation tests transport config validation
+func TestNewTransportValidation(t *testing.T) {
+	_, clientCertPEM, _ := newClientCertificate(t)
+	_, _, otherKeyPEM := newClientCertificate(t)
+
+	tests := []struct {
+		name          string
+		config        *TransportConfig
+		expectMessage string
+	}{
+		{
+			name:          "Nil config",
+			config:        nil,
+			expectMessage: "transport config is required",
+		},
+		{
+			name:          "Invalid CA certificate PEM",
+			config:        &TransportConfig{CACertPEM: "not a certificate"},
+			expectMessage: "no valid certificate found",
+		},
+		{
+			name:          "Missing CA certificate file",
+			config:        &TransportConfig{CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+			expectMessage: "failed to read CA certificate file",
+		},
+		{
+			name:          "Client certificate without key",
+			config:        &TransportConfig{ClientCertPEM: clientCertPEM},
+			expectMessage: "must be set together",
+		},
+		{
+			name:          "Mismatching client key",
+			config:        &TransportConfig{ClientCertPEM: clientCertPEM, ClientKeyPEM: otherKeyPEM},
+			expectMessage: "invalid client certificate",
+		},
+		{
+			name:          "Unsupported proxy scheme",
+			config:        &TransportConfig{ProxyURL: "socks5://proxy:1080"},
+			expectMessage: "the scheme must be http or https",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransport(tt.config)
+			if err == nil {
+				t.Fatalf("Expected error but got none")
+			}
+			if !strings.Contains(err.Error(), tt.expectMessage) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectMessage, err)
+			}
+		})
+	}
+}
@@ -120,7 +120,7 @@ func (d *eventDefinitionDataSource) Read(ctx context.Context, req datasource.Rea
 		eventDefID = state.ID.ValueString()
 	} else if !state.Title.IsNull() && state.Title.ValueString() != "" {
 		// Search by title
-		eventDefs, err := d.client.SearchEventDefinitionsByTitle(state.Title.ValueString())
+		eventDefs, err := d.client.SearchEventDefinitionsByTitle(ctx, state.Title.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Search Event Definitions",
@@ -155,7 +155,7 @@ func (d *eventDefinitionDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// Get event definition from Graylog API
-	eventDef, err := d.client.GetEventDefinition(eventDefID)
+	eventDef, err := d.client.GetEventDefinition(ctx, eventDefID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Event Definition",
@@ -0,0 +1,224 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &eventDefinitionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &eventDefinitionsDataSource{}
+)
+
+// NewEventDefinitionsDataSource is a helper function to simplify the provider implementation.
+func NewEventDefinitionsDataSource() datasource.DataSource {
+	return &eventDefinitionsDataSource{}
+}
+
+// eventDefinitionsDataSource is the data source implementation.
+type eventDefinitionsDataSource struct {
+	client *client.Client
+}
+
+// eventDefinitionsDataSourceModel maps the data source schema data.
+type eventDefinitionsDataSourceModel struct {
+	TitleRegex       types.String               `tfsdk:"title_regex"`
+	Priority         types.Int64                `tfsdk:"priority"`
+	Type             types.String               `tfsdk:"type"`
+	IDs              []types.String             `tfsdk:"ids"`
+	EventDefinitions []eventDefinitionItemModel `tfsdk:"event_definitions"`
+}
+
+// eventDefinitionItemModel maps an event definition of the list.
+type eventDefinitionItemModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Title           types.String   `tfsdk:"title"`
+	Description     types.String   `tfsdk:"description"`
+	Priority        types.Int64    `tfsdk:"priority"`
+	Alert           types.Bool     `tfsdk:"alert"`
+	State           types.String   `tfsdk:"state"`
+	Type            types.String   `tfsdk:"type"`
+	NotificationIDs []types.String `tfsdk:"notification_ids"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *eventDefinitionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *eventDefinitionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_definitions"
+}
+
+// Schema defines the schema for the data source.
+func (d *eventDefinitionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Graylog event definitions matching all the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"title_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list event definitions whose title matches this regular expression (RE2 syntax, unanchored).",
+				Optional:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Only list event definitions with this priority (1 = low, 2 = normal, 3 = high).",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list event definitions of this type, e.g. `aggregation-v1` or `correlation-v1`.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching event definitions.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"event_definitions": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching event definitions, ordered by title.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the event definition.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the event definition.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the event definition.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of the event definition.",
+							Computed:            true,
+						},
+						"alert": schema.BoolAttribute{
+							MarkdownDescription: "Whether the events are alerts.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The state of the event definition (`ENABLED` or `DISABLED`).",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the event definition.",
+							Computed:            true,
+						},
+						"notification_ids": schema.ListAttribute{
+							MarkdownDescription: "The IDs of the notifications attached to the event definition.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *eventDefinitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eventDefinitionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !state.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(state.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title_regex"),
+				"Invalid Title Regex",
+				"The title regex could not be compiled: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Get event definitions from Graylog API
+	eventDefs, err := d.client.ListEventDefinitions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Event Definitions",
+			"An error occurred while listing the event definitions: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(eventDefs, func(i, j int) bool {
+		return eventDefs[i].Title < eventDefs[j].Title
+	})
+
+	// Filter and map response to state
+	state.IDs = []types.String{}
+	state.EventDefinitions = []eventDefinitionItemModel{}
+	for _, eventDef := range eventDefs {
+		eventDefType := configType(eventDef.Config)
+		if titleRegex != nil && !titleRegex.MatchString(eventDef.Title) {
+			continue
+		}
+		if !state.Priority.IsNull() && int64(eventDef.Priority) != state.Priority.ValueInt64() {
+			continue
+		}
+		if !state.Type.IsNull() && eventDefType != state.Type.ValueString() {
+			continue
+		}
+
+		notificationIDs := []types.String{}
+		for _, notification := range eventDef.Notifications {
+			notificationIDs = append(notificationIDs, types.StringValue(notification.NotificationID))
+		}
+
+		state.IDs = append(state.IDs, types.StringValue(eventDef.ID))
+		state.EventDefinitions = append(state.EventDefinitions, eventDefinitionItemModel{
+			ID:              types.StringValue(eventDef.ID),
+			Title:           types.StringValue(eventDef.Title),
+			Description:     types.StringValue(eventDef.Description),
+			Priority:        types.Int64Value(int64(eventDef.Priority)),
+			Alert:           types.BoolValue(eventDef.Alert),
+			State:           types.StringValue(eventDef.State),
+			Type:            types.StringValue(eventDefType),
+			NotificationIDs: notificationIDs,
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// configType returns the type of an event definition or notification config.
+func configType(config map[string]interface{}) string {
+	if configType, ok := config["type"].(string); ok {
+		return configType
+	}
+	return ""
+}
@@ -95,7 +95,7 @@ func (d *eventNotificationDataSource) Read(ctx context.Context, req datasource.R
 		notificationID = state.ID.ValueString()
 	} else if !state.Title.IsNull() && state.Title.ValueString() != "" {
 		// Search by title
-		notifications, err := d.client.SearchEventNotificationsByTitle(state.Title.ValueString())
+		notifications, err := d.client.SearchEventNotificationsByTitle(ctx, state.Title.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Search Event Notifications",
@@ -130,7 +130,7 @@ func (d *eventNotificationDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	// Get event notification from Graylog API
-	notification, err := d.client.GetEventNotification(notificationID)
+	notification, err := d.client.GetEventNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Event Notification",
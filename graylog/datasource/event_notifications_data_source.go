@@ -0,0 +1,178 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &eventNotificationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &eventNotificationsDataSource{}
+)
+
+// NewEventNotificationsDataSource is a helper function to simplify the provider implementation.
+func NewEventNotificationsDataSource() datasource.DataSource {
+	return &eventNotificationsDataSource{}
+}
+
+// eventNotificationsDataSource is the data source implementation.
+type eventNotificationsDataSource struct {
+	client *client.Client
+}
+
+// eventNotificationsDataSourceModel maps the data source schema data.
+type eventNotificationsDataSourceModel struct {
+	TitleRegex    types.String                 `tfsdk:"title_regex"`
+	Type          types.String                 `tfsdk:"type"`
+	IDs           []types.String               `tfsdk:"ids"`
+	Notifications []eventNotificationItemModel `tfsdk:"notifications"`
+}
+
+// eventNotificationItemModel maps an event notification of the list.
+type eventNotificationItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *eventNotificationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *eventNotificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_notifications"
+}
+
+// Schema defines the schema for the data source.
+func (d *eventNotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Graylog event notifications matching all the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"title_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list notifications whose title matches this regular expression (RE2 syntax, unanchored).",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list notifications of this type, e.g. `email-notification-v1`, `http-notification-v1`, `slack-notification-v1` or `pagerduty-notification-v2`.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching notifications.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"notifications": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching notifications, ordered by title.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the notification.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the notification.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the notification.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the notification.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *eventNotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eventNotificationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !state.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(state.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title_regex"),
+				"Invalid Title Regex",
+				"The title regex could not be compiled: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Get event notifications from Graylog API
+	notifications, err := d.client.ListEventNotifications(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Event Notifications",
+			"An error occurred while listing the event notifications: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].Title < notifications[j].Title
+	})
+
+	// Filter and map response to state
+	state.IDs = []types.String{}
+	state.Notifications = []eventNotificationItemModel{}
+	for _, notification := range notifications {
+		notificationType := configType(notification.Config)
+		if titleRegex != nil && !titleRegex.MatchString(notification.Title) {
+			continue
+		}
+		if !state.Type.IsNull() && notificationType != state.Type.ValueString() {
+			continue
+		}
+
+		state.IDs = append(state.IDs, types.StringValue(notification.ID))
+		state.Notifications = append(state.Notifications, eventNotificationItemModel{
+			ID:          types.StringValue(notification.ID),
+			Title:       types.StringValue(notification.Title),
+			Description: types.StringValue(notification.Description),
+			Type:        types.StringValue(notificationType),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,256 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &indexSetDataSource{}
+	_ datasource.DataSourceWithConfigure = &indexSetDataSource{}
+)
+
+// NewIndexSetDataSource is a helper function to simplify the provider implementation.
+func NewIndexSetDataSource() datasource.DataSource {
+	return &indexSetDataSource{}
+}
+
+// indexSetDataSource is the data source implementation.
+type indexSetDataSource struct {
+	client *client.Client
+}
+
+// indexSetDataSourceModel maps the data source schema data.
+type indexSetDataSourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Title                           types.String `tfsdk:"title"`
+	IndexPrefix                     types.String `tfsdk:"index_prefix"`
+	Default                         types.Bool   `tfsdk:"default"`
+	Description                     types.String `tfsdk:"description"`
+	Shards                          types.Int64  `tfsdk:"shards"`
+	Replicas                        types.Int64  `tfsdk:"replicas"`
+	RotationStrategyClass           types.String `tfsdk:"rotation_strategy_class"`
+	RetentionStrategyClass          types.String `tfsdk:"retention_strategy_class"`
+	IndexAnalyzer                   types.String `tfsdk:"index_analyzer"`
+	IndexOptimizationMaxNumSegments types.Int64  `tfsdk:"index_optimization_max_num_segments"`
+	IndexOptimizationDisabled       types.Bool   `tfsdk:"index_optimization_disabled"`
+	FieldTypeRefreshInterval        types.Int64  `tfsdk:"field_type_refresh_interval"`
+	FieldTypeProfile                types.String `tfsdk:"field_type_profile"`
+	Writable                        types.Bool   `tfsdk:"writable"`
+	UseLegacyRotation               types.Bool   `tfsdk:"use_legacy_rotation"`
+	CreationDate                    types.String `tfsdk:"creation_date"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexSetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *indexSetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_set"
+}
+
+// Schema defines the schema for the data source.
+func (d *indexSetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches information about a specific Graylog index set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the index set. One of `id`, `title`, `index_prefix` or `default = true` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the index set. One of `id`, `title`, `index_prefix` or `default = true` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"index_prefix": schema.StringAttribute{
+				MarkdownDescription: "The index prefix of the index set. One of `id`, `title`, `index_prefix` or `default = true` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to look up the default index set. Reports whether the index set is the default one.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the index set.",
+				Computed:            true,
+			},
+			"shards": schema.Int64Attribute{
+				MarkdownDescription: "The number of shards per index.",
+				Computed:            true,
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "The number of replicas per index.",
+				Computed:            true,
+			},
+			"rotation_strategy_class": schema.StringAttribute{
+				MarkdownDescription: "The rotation strategy class of the index set.",
+				Computed:            true,
+			},
+			"retention_strategy_class": schema.StringAttribute{
+				MarkdownDescription: "The retention strategy class of the index set.",
+				Computed:            true,
+			},
+			"index_analyzer": schema.StringAttribute{
+				MarkdownDescription: "The analyzer used for the indices.",
+				Computed:            true,
+			},
+			"index_optimization_max_num_segments": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of segments per index after optimization.",
+				Computed:            true,
+			},
+			"index_optimization_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether index optimization after rotation is disabled.",
+				Computed:            true,
+			},
+			"field_type_refresh_interval": schema.Int64Attribute{
+				MarkdownDescription: "The field type refresh interval in milliseconds.",
+				Computed:            true,
+			},
+			"field_type_profile": schema.StringAttribute{
+				MarkdownDescription: "The ID of the index field type profile applied to the index set, if any.",
+				Computed:            true,
+			},
+			"writable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the index set is writable.",
+				Computed:            true,
+			},
+			"use_legacy_rotation": schema.BoolAttribute{
+				MarkdownDescription: "Whether the index set uses the rotation and retention strategies instead of data tiering.",
+				Computed:            true,
+			},
+			"creation_date": schema.StringAttribute{
+				MarkdownDescription: "The creation date of the index set.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *indexSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state indexSetDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var indexSet *client.IndexSet
+	if !state.ID.IsNull() && state.ID.ValueString() != "" {
+		// Get index set from Graylog API
+		var err error
+		indexSet, err = d.client.GetIndexSet(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Index Set",
+				"An error occurred while retrieving the index set: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		// Search the index sets by the configured criteria
+		var criteria string
+		var match func(client.IndexSet) bool
+		switch {
+		case !state.Title.IsNull() && state.Title.ValueString() != "":
+			criteria = "title: " + state.Title.ValueString()
+			match = func(i client.IndexSet) bool { return i.Title == state.Title.ValueString() }
+		case !state.IndexPrefix.IsNull() && state.IndexPrefix.ValueString() != "":
+			criteria = "index prefix: " + state.IndexPrefix.ValueString()
+			match = func(i client.IndexSet) bool { return i.IndexPrefix == state.IndexPrefix.ValueString() }
+		case state.Default.ValueBool():
+			criteria = "default: true"
+			match = func(i client.IndexSet) bool { return i.Default }
+		default:
+			resp.Diagnostics.AddError(
+				"Missing Index Set Identifier",
+				"One of 'id', 'title', 'index_prefix' or 'default = true' must be provided to identify the index set.",
+			)
+			return
+		}
+
+		indexSets, err := d.client.ListIndexSets(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Search Index Sets",
+				"An error occurred while searching for index sets: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []client.IndexSet
+		for _, candidate := range indexSets {
+			if match(candidate) {
+				matches = append(matches, candidate)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Index Set Not Found",
+				fmt.Sprintf("No index set found with %s", criteria),
+			)
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Index Sets Found",
+				fmt.Sprintf("Multiple index sets found with %s. Please use id instead.", criteria),
+			)
+			return
+		}
+
+		indexSet = &matches[0]
+	}
+
+	// Map response to state
+	state = indexSetDataSourceModel{
+		ID:                              types.StringValue(indexSet.ID),
+		Title:                           types.StringValue(indexSet.Title),
+		IndexPrefix:                     types.StringValue(indexSet.IndexPrefix),
+		Default:                         types.BoolValue(indexSet.Default),
+		Description:                     types.StringValue(indexSet.Description),
+		Shards:                          types.Int64Value(int64(indexSet.Shards)),
+		Replicas:                        types.Int64Value(int64(indexSet.Replicas)),
+		RotationStrategyClass:           types.StringValue(indexSet.RotationStrategyClass),
+		RetentionStrategyClass:          types.StringValue(indexSet.RetentionStrategyClass),
+		IndexAnalyzer:                   types.StringValue(indexSet.IndexAnalyzer),
+		IndexOptimizationMaxNumSegments: types.Int64Value(int64(indexSet.IndexOptimizationMaxNumSegments)),
+		IndexOptimizationDisabled:       types.BoolValue(indexSet.IndexOptimizationDisabled),
+		FieldTypeRefreshInterval:        types.Int64Value(int64(indexSet.FieldTypeRefreshInterval)),
+		FieldTypeProfile:                types.StringPointerValue(indexSet.FieldTypeProfile),
+		Writable:                        types.BoolValue(indexSet.Writable),
+		UseLegacyRotation:               types.BoolValue(indexSet.UseLegacyRotation),
+		CreationDate:                    types.StringValue(indexSet.CreationDate),
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,221 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &indexSetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &indexSetsDataSource{}
+)
+
+// NewIndexSetsDataSource is a helper function to simplify the provider implementation.
+func NewIndexSetsDataSource() datasource.DataSource {
+	return &indexSetsDataSource{}
+}
+
+// indexSetsDataSource is the data source implementation.
+type indexSetsDataSource struct {
+	client *client.Client
+}
+
+// indexSetsDataSourceModel maps the data source schema data.
+type indexSetsDataSourceModel struct {
+	IndexSets     []indexSetItemModel `tfsdk:"index_sets"`
+	IndexCount    types.Int64         `tfsdk:"index_count"`
+	DocumentCount types.Int64         `tfsdk:"document_count"`
+	SizeBytes     types.Int64         `tfsdk:"size_bytes"`
+}
+
+// indexSetItemModel maps an index set of the list.
+type indexSetItemModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Title                  types.String `tfsdk:"title"`
+	Description            types.String `tfsdk:"description"`
+	IndexPrefix            types.String `tfsdk:"index_prefix"`
+	Default                types.Bool   `tfsdk:"default"`
+	Writable               types.Bool   `tfsdk:"writable"`
+	Shards                 types.Int64  `tfsdk:"shards"`
+	Replicas               types.Int64  `tfsdk:"replicas"`
+	RotationStrategyClass  types.String `tfsdk:"rotation_strategy_class"`
+	RetentionStrategyClass types.String `tfsdk:"retention_strategy_class"`
+	UseLegacyRotation      types.Bool   `tfsdk:"use_legacy_rotation"`
+	CreationDate           types.String `tfsdk:"creation_date"`
+	IndexCount             types.Int64  `tfsdk:"index_count"`
+	DocumentCount          types.Int64  `tfsdk:"document_count"`
+	SizeBytes              types.Int64  `tfsdk:"size_bytes"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexSetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *indexSetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_sets"
+}
+
+// Schema defines the schema for the data source.
+func (d *indexSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Graylog index sets along with their statistics.",
+		Attributes: map[string]schema.Attribute{
+			"index_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of indices of all index sets.",
+				Computed:            true,
+			},
+			"document_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of documents of all index sets.",
+				Computed:            true,
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The size of all index sets in bytes.",
+				Computed:            true,
+			},
+			"index_sets": schema.ListNestedAttribute{
+				MarkdownDescription: "The index sets, ordered by title.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the index set.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the index set.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the index set.",
+							Computed:            true,
+						},
+						"index_prefix": schema.StringAttribute{
+							MarkdownDescription: "The index prefix of the index set.",
+							Computed:            true,
+						},
+						"default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the index set is the default one.",
+							Computed:            true,
+						},
+						"writable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the index set is writable.",
+							Computed:            true,
+						},
+						"shards": schema.Int64Attribute{
+							MarkdownDescription: "The number of shards per index.",
+							Computed:            true,
+						},
+						"replicas": schema.Int64Attribute{
+							MarkdownDescription: "The number of replicas per index.",
+							Computed:            true,
+						},
+						"rotation_strategy_class": schema.StringAttribute{
+							MarkdownDescription: "The rotation strategy class of the index set.",
+							Computed:            true,
+						},
+						"retention_strategy_class": schema.StringAttribute{
+							MarkdownDescription: "The retention strategy class of the index set.",
+							Computed:            true,
+						},
+						"use_legacy_rotation": schema.BoolAttribute{
+							MarkdownDescription: "Whether the index set uses the rotation and retention strategies instead of data tiering.",
+							Computed:            true,
+						},
+						"creation_date": schema.StringAttribute{
+							MarkdownDescription: "The creation date of the index set.",
+							Computed:            true,
+						},
+						"index_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of indices of the index set.",
+							Computed:            true,
+						},
+						"document_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of documents of the index set.",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The size of the index set in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *indexSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state indexSetsDataSourceModel
+
+	// Get index sets from Graylog API
+	indexSets, stats, err := d.client.ListIndexSetsWithStats(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Index Sets",
+			"An error occurred while listing the index sets: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(indexSets, func(i, j int) bool {
+		return indexSets[i].Title < indexSets[j].Title
+	})
+
+	// Map response to state
+	var totals client.IndexSetStats
+	state.IndexSets = make([]indexSetItemModel, 0, len(indexSets))
+	for _, indexSet := range indexSets {
+		indexSetStats := stats[indexSet.ID]
+		totals.Indices += indexSetStats.Indices
+		totals.Documents += indexSetStats.Documents
+		totals.Size += indexSetStats.Size
+
+		state.IndexSets = append(state.IndexSets, indexSetItemModel{
+			ID:                     types.StringValue(indexSet.ID),
+			Title:                  types.StringValue(indexSet.Title),
+			Description:            types.StringValue(indexSet.Description),
+			IndexPrefix:            types.StringValue(indexSet.IndexPrefix),
+			Default:                types.BoolValue(indexSet.Default),
+			Writable:               types.BoolValue(indexSet.Writable),
+			Shards:                 types.Int64Value(int64(indexSet.Shards)),
+			Replicas:               types.Int64Value(int64(indexSet.Replicas)),
+			RotationStrategyClass:  types.StringValue(indexSet.RotationStrategyClass),
+			RetentionStrategyClass: types.StringValue(indexSet.RetentionStrategyClass),
+			UseLegacyRotation:      types.BoolValue(indexSet.UseLegacyRotation),
+			CreationDate:           types.StringValue(indexSet.CreationDate),
+			IndexCount:             types.Int64Value(indexSetStats.Indices),
+			DocumentCount:          types.Int64Value(indexSetStats.Documents),
+			SizeBytes:              types.Int64Value(indexSetStats.Size),
+		})
+	}
+
+	state.IndexCount = types.Int64Value(totals.Indices)
+	state.DocumentCount = types.Int64Value(totals.Documents)
+	state.SizeBytes = types.Int64Value(totals.Size)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,268 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &inputDataSource{}
+	_ datasource.DataSourceWithConfigure = &inputDataSource{}
+)
+
+// NewInputDataSource is a helper function to simplify the provider implementation.
+func NewInputDataSource() datasource.DataSource {
+	return &inputDataSource{}
+}
+
+// inputDataSource is the data source implementation.
+type inputDataSource struct {
+	client *client.Client
+}
+
+// inputDataSourceModel maps the data source schema data.
+type inputDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	Type          types.String `tfsdk:"type"`
+	Global        types.Bool   `tfsdk:"global"`
+	Node          types.String `tfsdk:"node"`
+	Attributes    types.Map    `tfsdk:"attributes"`
+	StaticFields  types.Map    `tfsdk:"static_fields"`
+	CreatorUserID types.String `tfsdk:"creator_user_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *inputDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *inputDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input"
+}
+
+// Schema defines the schema for the data source.
+func (d *inputDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches information about a specific Graylog input.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the input. Either `id` or `title` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the input. Either `id` or `title` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the input. When looking up by `title`, only inputs of this type are considered.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"global": schema.BoolAttribute{
+				MarkdownDescription: "Whether the input runs on all nodes.",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "The node ID the input runs on, if not global.",
+				Computed:            true,
+			},
+			"attributes": schema.MapAttribute{
+				MarkdownDescription: "The configuration attributes of the input. Passwords are masked by Graylog; list values are comma-separated.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"static_fields": schema.MapAttribute{
+				MarkdownDescription: "The static fields added to every message received by the input.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"creator_user_id": schema.StringAttribute{
+				MarkdownDescription: "The user who created the input.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation time of the input (RFC 3339).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *inputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state inputDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine which identifier to use
+	var inputID string
+	if !state.ID.IsNull() && state.ID.ValueString() != "" {
+		inputID = state.ID.ValueString()
+	} else if !state.Title.IsNull() && state.Title.ValueString() != "" {
+		// Search by title
+		inputs, err := d.client.SearchInputsByTitle(ctx, state.Title.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Search Inputs",
+				"An error occurred while searching for inputs by title: "+err.Error(),
+			)
+			return
+		}
+
+		if !state.Type.IsNull() && state.Type.ValueString() != "" {
+			var filtered []client.Input
+			for _, input := range inputs {
+				if input.Type == state.Type.ValueString() {
+					filtered = append(filtered, input)
+				}
+			}
+			inputs = filtered
+		}
+
+		if len(inputs) == 0 {
+			resp.Diagnostics.AddError(
+				"Input Not Found",
+				fmt.Sprintf("No input found with title: %s", state.Title.ValueString()),
+			)
+			return
+		}
+
+		if len(inputs) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Inputs Found",
+				fmt.Sprintf("Multiple inputs found with title: %s. Please set type or use id instead.", state.Title.ValueString()),
+			)
+			return
+		}
+
+		inputID = inputs[0].ID
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing Input Identifier",
+			"Either 'id' or 'title' must be provided to identify the input.",
+		)
+		return
+	}
+
+	// Get input from Graylog API
+	input, err := d.client.GetInput(ctx, inputID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Input",
+			"An error occurred while retrieving the input: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	model, diags := inputModel(ctx, input)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// inputModel maps an input to the data source model.
+func inputModel(ctx context.Context, input *client.Input) (inputDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := inputDataSourceModel{
+		ID:            types.StringValue(input.ID),
+		Title:         types.StringValue(input.Title),
+		Type:          types.StringValue(input.Type),
+		Global:        types.BoolValue(input.Global),
+		Node:          types.StringNull(),
+		CreatorUserID: types.StringValue(input.CreatorUserID),
+		CreatedAt:     types.StringNull(),
+	}
+	if input.Node != "" {
+		model.Node = types.StringValue(input.Node)
+	}
+	if !input.CreatedAt.IsZero() {
+		model.CreatedAt = types.StringValue(input.CreatedAt.Format(time.RFC3339))
+	}
+
+	attributes, d := types.MapValueFrom(ctx, types.StringType, inputAttributeStrings(input.Attributes))
+	diags.Append(d...)
+	model.Attributes = attributes
+
+	staticFields := input.StaticFields
+	if staticFields == nil {
+		staticFields = map[string]string{}
+	}
+	staticFieldsValue, d := types.MapValueFrom(ctx, types.StringType, staticFields)
+	diags.Append(d...)
+	model.StaticFields = staticFieldsValue
+
+	return model, diags
+}
+
+// inputAttributeStrings converts the attributes returned by Graylog into
+// their string form, skipping unset values.
+func inputAttributeStrings(attributes map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		if value == nil {
+			continue
+		}
+		result[key] = inputAttributeString(value)
+	}
+	return result
+}
+
+// inputAttributeString converts an attribute value into its string form.
+func inputAttributeString(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, inputAttributeString(item))
+		}
+		return strings.Join(items, ",")
+	case map[string]interface{}:
+		if encoded, err := json.Marshal(v); err == nil {
+			return string(encoded)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,227 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &inputTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &inputTypesDataSource{}
+)
+
+// NewInputTypesDataSource is a helper function to simplify the provider implementation.
+func NewInputTypesDataSource() datasource.DataSource {
+	return &inputTypesDataSource{}
+}
+
+// inputTypesDataSource is the data source implementation.
+type inputTypesDataSource struct {
+	client *client.Client
+}
+
+// inputTypesDataSourceModel maps the data source schema data.
+type inputTypesDataSourceModel struct {
+	Type  types.String     `tfsdk:"type"`
+	Types []inputTypeModel `tfsdk:"types"`
+}
+
+// inputTypeModel maps an input type.
+type inputTypeModel struct {
+	Type                   types.String                            `tfsdk:"type"`
+	Name                   types.String                            `tfsdk:"name"`
+	IsExclusive            types.Bool                              `tfsdk:"is_exclusive"`
+	LinkToDocs             types.String                            `tfsdk:"link_to_docs"`
+	RequestedConfiguration map[string]inputConfigurationFieldModel `tfsdk:"requested_configuration"`
+}
+
+// inputConfigurationFieldModel maps a configuration field of an input type.
+type inputConfigurationFieldModel struct {
+	Type         types.String   `tfsdk:"type"`
+	HumanName    types.String   `tfsdk:"human_name"`
+	Description  types.String   `tfsdk:"description"`
+	DefaultValue types.String   `tfsdk:"default_value"`
+	IsOptional   types.Bool     `tfsdk:"is_optional"`
+	IsEncrypted  types.Bool     `tfsdk:"is_encrypted"`
+	Attributes   []types.String `tfsdk:"attributes"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *inputTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *inputTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_types"
+}
+
+// Schema defines the schema for the data source.
+func (d *inputTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the input types supported by the Graylog cluster and the configuration each of them requests.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list this input type (e.g. `org.graylog2.inputs.syslog.udp.SyslogUDPInput`).",
+				Optional:            true,
+			},
+			"types": schema.ListNestedAttribute{
+				MarkdownDescription: "The input types, ordered by type.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the input, used as `type` of `graylog_input`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The human readable name of the input type.",
+							Computed:            true,
+						},
+						"is_exclusive": schema.BoolAttribute{
+							MarkdownDescription: "Whether only a single input of this type can exist.",
+							Computed:            true,
+						},
+						"link_to_docs": schema.StringAttribute{
+							MarkdownDescription: "The documentation link of the input type.",
+							Computed:            true,
+						},
+						"requested_configuration": schema.MapNestedAttribute{
+							MarkdownDescription: "The configuration fields of the input type, keyed by attribute name.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of the field: `text`, `number`, `boolean`, `dropdown`, `list` or `encrypted`.",
+										Computed:            true,
+									},
+									"human_name": schema.StringAttribute{
+										MarkdownDescription: "The human readable name of the field.",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "The description of the field.",
+										Computed:            true,
+									},
+									"default_value": schema.StringAttribute{
+										MarkdownDescription: "The default value of the field, if any.",
+										Computed:            true,
+									},
+									"is_optional": schema.BoolAttribute{
+										MarkdownDescription: "Whether the field may be omitted.",
+										Computed:            true,
+									},
+									"is_encrypted": schema.BoolAttribute{
+										MarkdownDescription: "Whether Graylog stores the field encrypted. Such fields are set with `attributes_wo`.",
+										Computed:            true,
+									},
+									"attributes": schema.ListAttribute{
+										MarkdownDescription: "Additional attributes of the field, e.g. `is_password`.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *inputTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state inputTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get input types from Graylog API
+	var inputTypes map[string]client.InputType
+	if !state.Type.IsNull() && state.Type.ValueString() != "" {
+		inputType, err := d.client.GetInputType(ctx, state.Type.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Input Type",
+				"An error occurred while retrieving the input type: "+err.Error(),
+			)
+			return
+		}
+		inputTypes = map[string]client.InputType{inputType.Type: *inputType}
+	} else {
+		var err error
+		inputTypes, err = d.client.ListInputTypes(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Input Types",
+				"An error occurred while listing the input types: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	names := make([]string, 0, len(inputTypes))
+	for name := range inputTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Map response to state
+	state.Types = make([]inputTypeModel, 0, len(names))
+	for _, name := range names {
+		inputType := inputTypes[name]
+		model := inputTypeModel{
+			Type:                   types.StringValue(name),
+			Name:                   types.StringValue(inputType.Name),
+			IsExclusive:            types.BoolValue(inputType.IsExclusive),
+			LinkToDocs:             types.StringValue(inputType.LinkToDocs),
+			RequestedConfiguration: make(map[string]inputConfigurationFieldModel, len(inputType.RequestedConfiguration)),
+		}
+		for key, field := range inputType.RequestedConfiguration {
+			fieldModel := inputConfigurationFieldModel{
+				Type:         types.StringValue(field.Type),
+				HumanName:    types.StringValue(field.HumanName),
+				Description:  types.StringValue(field.Description),
+				DefaultValue: types.StringNull(),
+				IsOptional:   types.BoolValue(field.IsOptional),
+				IsEncrypted:  types.BoolValue(field.IsEncrypted),
+				Attributes:   []types.String{},
+			}
+			if field.DefaultValue != nil {
+				fieldModel.DefaultValue = types.StringValue(inputAttributeString(field.DefaultValue))
+			}
+			for _, attribute := range field.Attributes {
+				fieldModel.Attributes = append(fieldModel.Attributes, types.StringValue(attribute))
+			}
+			model.RequestedConfiguration[key] = fieldModel
+		}
+		state.Types = append(state.Types, model)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
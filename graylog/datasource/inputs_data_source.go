@@ -0,0 +1,176 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &inputsDataSource{}
+	_ datasource.DataSourceWithConfigure = &inputsDataSource{}
+)
+
+// NewInputsDataSource is a helper function to simplify the provider implementation.
+func NewInputsDataSource() datasource.DataSource {
+	return &inputsDataSource{}
+}
+
+// inputsDataSource is the data source implementation.
+type inputsDataSource struct {
+	client *client.Client
+}
+
+// inputsDataSourceModel maps the data source schema data.
+type inputsDataSourceModel struct {
+	Title  types.String           `tfsdk:"title"`
+	Type   types.String           `tfsdk:"type"`
+	Global types.Bool             `tfsdk:"global"`
+	Inputs []inputDataSourceModel `tfsdk:"inputs"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *inputsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *inputsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inputs"
+}
+
+// Schema defines the schema for the data source.
+func (d *inputsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Graylog inputs, optionally filtered by title, type and scope.",
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Only list inputs with this title.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list inputs of this type (e.g. `org.graylog2.inputs.syslog.udp.SyslogUDPInput`).",
+				Optional:            true,
+			},
+			"global": schema.BoolAttribute{
+				MarkdownDescription: "Only list global inputs when `true`, or node-local inputs when `false`.",
+				Optional:            true,
+			},
+			"inputs": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching inputs, ordered by title.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the input.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the input.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the input.",
+							Computed:            true,
+						},
+						"global": schema.BoolAttribute{
+							MarkdownDescription: "Whether the input runs on all nodes.",
+							Computed:            true,
+						},
+						"node": schema.StringAttribute{
+							MarkdownDescription: "The node ID the input runs on, if not global.",
+							Computed:            true,
+						},
+						"attributes": schema.MapAttribute{
+							MarkdownDescription: "The configuration attributes of the input. Passwords are masked by Graylog; list values are comma-separated.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"static_fields": schema.MapAttribute{
+							MarkdownDescription: "The static fields added to every message received by the input.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"creator_user_id": schema.StringAttribute{
+							MarkdownDescription: "The user who created the input.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The creation time of the input (RFC 3339).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *inputsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state inputsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get inputs from Graylog API
+	inputs, err := d.client.ListInputs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Inputs",
+			"An error occurred while listing the inputs: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(inputs, func(i, j int) bool {
+		return inputs[i].Title < inputs[j].Title
+	})
+
+	// Filter and map response to state
+	state.Inputs = []inputDataSourceModel{}
+	for i := range inputs {
+		input := &inputs[i]
+		if !state.Title.IsNull() && input.Title != state.Title.ValueString() {
+			continue
+		}
+		if !state.Type.IsNull() && input.Type != state.Type.ValueString() {
+			continue
+		}
+		if !state.Global.IsNull() && input.Global != state.Global.ValueBool() {
+			continue
+		}
+
+		model, diags := inputModel(ctx, input)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Inputs = append(state.Inputs, model)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
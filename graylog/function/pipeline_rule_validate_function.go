@@ -0,0 +1,85 @@
+package function
+
+import (
+	"context"
+
+	"terraform-provider-graylog/graylog/rulelang"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &pipelineRuleValidateFunction{}
+)
+
+// pipelineRuleErrorAttrTypes describes the objects returned for each syntax error.
+var pipelineRuleErrorAttrTypes = map[string]attr.Type{
+	"line":    types.Int64Type,
+	"column":  types.Int64Type,
+	"message": types.StringType,
+}
+
+// NewPipelineRuleValidateFunction is a helper function to simplify the provider implementation.
+func NewPipelineRuleValidateFunction() function.Function {
+	return &pipelineRuleValidateFunction{}
+}
+
+// pipelineRuleValidateFunction is the function implementation.
+type pipelineRuleValidateFunction struct{}
+
+// pipelineRuleErrorModel maps a single syntax error to the returned object.
+type pipelineRuleErrorModel struct {
+	Line    types.Int64  `tfsdk:"line"`
+	Column  types.Int64  `tfsdk:"column"`
+	Message types.String `tfsdk:"message"`
+}
+
+// Metadata returns the function name.
+func (f *pipelineRuleValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pipeline_rule_validate"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *pipelineRuleValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validates the syntax of a Graylog pipeline rule.",
+		MarkdownDescription: "Parses a Graylog pipeline rule (`rule \"name\" when ... then ... end`) without contacting the server " +
+			"and returns the syntax errors found as a list of objects with `line`, `column` and `message` attributes. " +
+			"The list is empty for a valid rule. Function names and argument types are not checked.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "source",
+				MarkdownDescription: "The pipeline rule source code.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: pipelineRuleErrorAttrTypes,
+			},
+		},
+	}
+}
+
+// Run parses the rule source and returns its syntax errors.
+func (f *pipelineRuleValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var source string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &source))
+	if resp.Error != nil {
+		return
+	}
+
+	errs := rulelang.Validate(source)
+	result := make([]pipelineRuleErrorModel, 0, len(errs))
+	for _, err := range errs {
+		result = append(result, pipelineRuleErrorModel{
+			Line:    types.Int64Value(int64(err.Line)),
+			Column:  types.Int64Value(int64(err.Column)),
+			Message: types.StringValue(err.Message),
+		})
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
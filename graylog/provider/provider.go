@@ -3,11 +3,17 @@ package provider
 import (
     "context"
     "os"
+    "strconv"
+    "time"
 
     "terraform-provider-graylog/graylog/client"
+    graylogact "terraform-provider-graylog/graylog/action"
     graylogds "terraform-provider-graylog/graylog/datasource"
+    graylogfn "terraform-provider-graylog/graylog/function"
     graylogres "terraform-provider-graylog/graylog/resource"
+    "github.com/hashicorp/terraform-plugin-framework/action"
     "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/function"
     "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/provider"
     "github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -20,7 +26,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-    _ provider.Provider = &graylogProvider{}
+    _ provider.Provider              = &graylogProvider{}
+    _ provider.ProviderWithFunctions = &graylogProvider{}
+    _ provider.ProviderWithActions   = &graylogProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -42,11 +50,22 @@ type graylogProvider struct {
 
 // graylogProviderModel maps provider schema data to a Go type.
 type graylogProviderModel struct {
-    Endpoint        types.String `tfsdk:"web_endpoint_uri"`
-    AuthName        types.String `tfsdk:"auth_name"`
-    AuthPassword    types.String `tfsdk:"auth_password"`
-    XRequestedBy    types.String `tfsdk:"x_requested_by"`
-    APIVersion      types.String `tfsdk:"api_version"`
+    Endpoint              types.String `tfsdk:"web_endpoint_uri"`
+    AuthName              types.String `tfsdk:"auth_name"`
+    AuthPassword          types.String `tfsdk:"auth_password"`
+    AuthToken             types.String `tfsdk:"auth_token"`
+    AuthMode              types.String `tfsdk:"auth_mode"`
+    XRequestedBy          types.String `tfsdk:"x_requested_by"`
+    APIVersion            types.String `tfsdk:"api_version"`
+    RetryMaxAttempts      types.Int64  `tfsdk:"retry_max_attempts"`
+    RetryMaxDuration      types.String `tfsdk:"retry_max_duration"`
+    CACertPEM             types.String `tfsdk:"ca_cert_pem"`
+    CACertFile            types.String `tfsdk:"ca_cert_file"`
+    ClientCert            types.String `tfsdk:"client_cert"`
+    ClientKey             types.String `tfsdk:"client_key"`
+    TLSServerName         types.String `tfsdk:"tls_server_name"`
+    TLSInsecureSkipVerify types.Bool   `tfsdk:"tls_insecure_skip_verify"`
+    ProxyURL              types.String `tfsdk:"proxy_url"`
 }
 
 // Metadata returns the provider type name.
@@ -65,14 +84,23 @@ func (p *graylogProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
                 Required:    true,
             },
             "auth_name": schema.StringAttribute{
-                MarkdownDescription: "The username for authenticating with the Graylog API. Can also be set via `GRAYLOG_AUTH_NAME` environment variable.",
-                Required:    true,
+                MarkdownDescription: "The username for authenticating with the Graylog API. Required by the `basic` and `session` authentication modes. Can also be set via `GRAYLOG_AUTH_NAME` environment variable.",
+                Optional:    true,
             },
             "auth_password": schema.StringAttribute{
-                MarkdownDescription: "The password for authenticating with the Graylog API. Can also be set via `GRAYLOG_AUTH_PASSWORD` environment variable.",
-                Required:    true,
+                MarkdownDescription: "The password for authenticating with the Graylog API. Required by the `basic` and `session` authentication modes. Can also be set via `GRAYLOG_AUTH_PASSWORD` environment variable.",
+                Optional:    true,
                 Sensitive:   true,
             },
+            "auth_token": schema.StringAttribute{
+                MarkdownDescription: "An access token for authenticating with the Graylog API, sent as `<token>:token`. Required by the `token` authentication mode. Can also be set via `GRAYLOG_AUTH_TOKEN` environment variable.",
+                Optional:    true,
+                Sensitive:   true,
+            },
+            "auth_mode": schema.StringAttribute{
+                MarkdownDescription: "How to authenticate with the Graylog API: `basic` sends `auth_name` and `auth_password` with every request, `token` sends `auth_token` and `session` logs in with `auth_name` and `auth_password` once, then sends the session ID and logs in again when the session expires. Can also be set via `GRAYLOG_AUTH_MODE` environment variable. Defaults to `token` when an `auth_token` is set, `basic` otherwise.",
+                Optional:    true,
+            },
             "x_requested_by": schema.StringAttribute{
                 MarkdownDescription: "Custom value for the X-Requested-By header. Can also be set via `GRAYLOG_X_REQUESTED_BY` environment variable. Defaults to `terraform-provider-graylog`.",
                 Optional:    true,
@@ -81,6 +109,43 @@ func (p *graylogProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
                 MarkdownDescription: "The Graylog API version to use. Can also be set via `GRAYLOG_API_VERSION` environment variable. Defaults to `v3`.",
                 Optional:    true,
             },
+            "retry_max_attempts": schema.Int64Attribute{
+                MarkdownDescription: "The maximum number of attempts of an API request failing with a transient error. Requests that could not connect are retried, dropped connections and `429`, `502`, `503` or `504` responses only for idempotent requests (`GET`, `PUT`, `DELETE`). Attempts are spaced by a jittered exponential backoff or the `Retry-After` response header. Set to `1` to disable retries. Can also be set via `GRAYLOG_RETRY_MAX_ATTEMPTS` environment variable. Defaults to `5`.",
+                Optional:    true,
+            },
+            "retry_max_duration": schema.StringAttribute{
+                MarkdownDescription: "The maximum time spent retrying an API request, as a duration like `90s` or `5m`. Can also be set via `GRAYLOG_RETRY_MAX_DURATION` environment variable. Defaults to `2m`.",
+                Optional:    true,
+            },
+            "ca_cert_pem": schema.StringAttribute{
+                MarkdownDescription: "PEM encoded CA certificates trusted to verify the Graylog server certificate, in addition to the system certificate pool. Can also be set via `GRAYLOG_CA_CERT_PEM` environment variable.",
+                Optional:    true,
+            },
+            "ca_cert_file": schema.StringAttribute{
+                MarkdownDescription: "Path to a file of PEM encoded CA certificates trusted to verify the Graylog server certificate, in addition to the system certificate pool. Can also be set via `GRAYLOG_CA_CERT_FILE` environment variable.",
+                Optional:    true,
+            },
+            "client_cert": schema.StringAttribute{
+                MarkdownDescription: "PEM encoded client certificate presented to servers requiring mutual TLS, e.g. `file(\"client.crt\")`. Requires `client_key`. Can also be set via `GRAYLOG_CLIENT_CERT` environment variable.",
+                Optional:    true,
+            },
+            "client_key": schema.StringAttribute{
+                MarkdownDescription: "PEM encoded private key of `client_cert`. Can also be set via `GRAYLOG_CLIENT_KEY` environment variable.",
+                Optional:    true,
+                Sensitive:   true,
+            },
+            "tls_server_name": schema.StringAttribute{
+                MarkdownDescription: "The host name used to verify the Graylog server certificate, when it differs from the host of `web_endpoint_uri`. Can also be set via `GRAYLOG_TLS_SERVER_NAME` environment variable.",
+                Optional:    true,
+            },
+            "tls_insecure_skip_verify": schema.BoolAttribute{
+                MarkdownDescription: "Skip the verification of the Graylog server certificate. Only use this for testing, as it exposes the connection to man-in-the-middle attacks. Can also be set via `GRAYLOG_TLS_INSECURE_SKIP_VERIFY` environment variable. Defaults to `false`.",
+                Optional:    true,
+            },
+            "proxy_url": schema.StringAttribute{
+                MarkdownDescription: "The URL of the HTTP(S) proxy used to reach the Graylog API, e.g. `http://proxy.example.com:3128`. Can also be set via `GRAYLOG_PROXY_URL` environment variable. Defaults to the proxy of the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+                Optional:    true,
+            },
         },
     }
 }
@@ -128,6 +193,24 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
         )
     }
 
+    if config.AuthToken.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("auth_token"),
+            "Unknown Graylog API Token",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog API token. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_AUTH_TOKEN environment variable.",
+        )
+    }
+
+    if config.AuthMode.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("auth_mode"),
+            "Unknown Graylog API Auth Mode",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog API auth mode. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_AUTH_MODE environment variable.",
+        )
+    }
+
     if config.XRequestedBy.IsUnknown() {
         resp.Diagnostics.AddAttributeError(
             path.Root("x_requested_by"),
@@ -146,6 +229,87 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
         )
     }
 
+    if config.RetryMaxAttempts.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("retry_max_attempts"),
+            "Unknown Graylog Retry Max Attempts",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog retry max attempts. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_RETRY_MAX_ATTEMPTS environment variable.",
+        )
+    }
+
+    if config.RetryMaxDuration.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("retry_max_duration"),
+            "Unknown Graylog Retry Max Duration",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog retry max duration. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_RETRY_MAX_DURATION environment variable.",
+        )
+    }
+
+    if config.CACertPEM.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("ca_cert_pem"),
+            "Unknown Graylog CA Certificate PEM",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog CA certificate PEM. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_CA_CERT_PEM environment variable.",
+        )
+    }
+
+    if config.CACertFile.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("ca_cert_file"),
+            "Unknown Graylog CA Certificate File",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog CA certificate file. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_CA_CERT_FILE environment variable.",
+        )
+    }
+
+    if config.ClientCert.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("client_cert"),
+            "Unknown Graylog Client Certificate",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog client certificate. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_CLIENT_CERT environment variable.",
+        )
+    }
+
+    if config.ClientKey.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("client_key"),
+            "Unknown Graylog Client Key",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog client key. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_CLIENT_KEY environment variable.",
+        )
+    }
+
+    if config.TLSServerName.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("tls_server_name"),
+            "Unknown Graylog TLS Server Name",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog TLS server name. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_TLS_SERVER_NAME environment variable.",
+        )
+    }
+
+    if config.TLSInsecureSkipVerify.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("tls_insecure_skip_verify"),
+            "Unknown Graylog TLS Insecure Skip Verify",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog TLS insecure skip verify. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_TLS_INSECURE_SKIP_VERIFY environment variable.",
+        )
+    }
+
+    if config.ProxyURL.IsUnknown() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("proxy_url"),
+            "Unknown Graylog Proxy URL",
+            "The provider cannot create the Graylog API client as there is an unknown configuration value for the Graylog proxy URL. "+
+                "Either target apply the source of the value first, set the value statically in the configuration, or use the GRAYLOG_PROXY_URL environment variable.",
+        )
+    }
+
     if resp.Diagnostics.HasError() {
         return
     }
@@ -156,8 +320,19 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
     endpoint := os.Getenv("GRAYLOG_WEB_ENDPOINT_URI")
     auth_name := os.Getenv("GRAYLOG_AUTH_NAME")
     auth_password := os.Getenv("GRAYLOG_AUTH_PASSWORD")
+    auth_token := os.Getenv("GRAYLOG_AUTH_TOKEN")
+    auth_mode := os.Getenv("GRAYLOG_AUTH_MODE")
     x_requested_by := os.Getenv("GRAYLOG_X_REQUESTED_BY")
     api_version := os.Getenv("GRAYLOG_API_VERSION")
+    retry_max_attempts := os.Getenv("GRAYLOG_RETRY_MAX_ATTEMPTS")
+    retry_max_duration := os.Getenv("GRAYLOG_RETRY_MAX_DURATION")
+    ca_cert_pem := os.Getenv("GRAYLOG_CA_CERT_PEM")
+    ca_cert_file := os.Getenv("GRAYLOG_CA_CERT_FILE")
+    client_cert := os.Getenv("GRAYLOG_CLIENT_CERT")
+    client_key := os.Getenv("GRAYLOG_CLIENT_KEY")
+    tls_server_name := os.Getenv("GRAYLOG_TLS_SERVER_NAME")
+    tls_insecure_skip_verify := os.Getenv("GRAYLOG_TLS_INSECURE_SKIP_VERIFY")
+    proxy_url := os.Getenv("GRAYLOG_PROXY_URL")
 
     if !config.Endpoint.IsNull() {
         endpoint = config.Endpoint.ValueString()
@@ -170,6 +345,14 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
     if !config.AuthPassword.IsNull() {
         auth_password = config.AuthPassword.ValueString()
     }
+
+    if !config.AuthToken.IsNull() {
+        auth_token = config.AuthToken.ValueString()
+    }
+
+    if !config.AuthMode.IsNull() {
+        auth_mode = config.AuthMode.ValueString()
+    }
     
     if !config.XRequestedBy.IsNull() {
         x_requested_by = config.XRequestedBy.ValueString()
@@ -179,7 +362,50 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
         api_version = config.APIVersion.ValueString()
     }
 
+    if !config.RetryMaxAttempts.IsNull() {
+        retry_max_attempts = strconv.FormatInt(config.RetryMaxAttempts.ValueInt64(), 10)
+    }
+
+    if !config.RetryMaxDuration.IsNull() {
+        retry_max_duration = config.RetryMaxDuration.ValueString()
+    }
+
+    if !config.CACertPEM.IsNull() {
+        ca_cert_pem = config.CACertPEM.ValueString()
+    }
+
+    if !config.CACertFile.IsNull() {
+        ca_cert_file = config.CACertFile.ValueString()
+    }
+
+    if !config.ClientCert.IsNull() {
+        client_cert = config.ClientCert.ValueString()
+    }
+
+    if !config.ClientKey.IsNull() {
+        client_key = config.ClientKey.ValueString()
+    }
+
+    if !config.TLSServerName.IsNull() {
+        tls_server_name = config.TLSServerName.ValueString()
+    }
+
+    if !config.TLSInsecureSkipVerify.IsNull() {
+        tls_insecure_skip_verify = strconv.FormatBool(config.TLSInsecureSkipVerify.ValueBool())
+    }
+
+    if !config.ProxyURL.IsNull() {
+        proxy_url = config.ProxyURL.ValueString()
+    }
+
     // Apply default values for optional fields
+    if auth_mode == "" {
+        auth_mode = client.AuthModeBasic
+        if auth_token != "" {
+            auth_mode = client.AuthModeToken
+        }
+    }
+
     if x_requested_by == "" {
         x_requested_by = "terraform-provider-graylog"
     }
@@ -201,23 +427,107 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
         )
     }
 
-    if auth_name == "" {
+    switch auth_mode {
+    case client.AuthModeBasic, client.AuthModeSession:
+        if auth_name == "" {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("auth_name"),
+                "Missing Graylog API Auth Name",
+                "The provider cannot create the Graylog API client as there is a missing or empty value for the Graylog API auth name. "+
+                    "Set the auth_name value in the configuration or use the GRAYLOG_AUTH_NAME environment variable. "+
+                    "If either is already set, ensure the value is not empty.",
+            )
+        }
+
+        if auth_password == "" {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("auth_password"),
+                "Missing Graylog API Auth Password",
+                "The provider cannot create the Graylog API client as there is a missing or empty value for the Graylog API auth password. "+
+                    "Set the auth_password value in the configuration or use the GRAYLOG_AUTH_PASSWORD environment variable. "+
+                    "If either is already set, ensure the value is not empty.",
+            )
+        }
+
+    case client.AuthModeToken:
+        if auth_token == "" {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("auth_token"),
+                "Missing Graylog API Token",
+                "The provider cannot create the Graylog API client as there is a missing or empty value for the Graylog API token. "+
+                    "Set the auth_token value in the configuration or use the GRAYLOG_AUTH_TOKEN environment variable. "+
+                    "If either is already set, ensure the value is not empty.",
+            )
+        }
+
+    default:
         resp.Diagnostics.AddAttributeError(
-            path.Root("auth_name"),
-            "Missing Graylog API Auth Name",
-            "The provider cannot create the Graylog API client as there is a missing or empty value for the Graylog API auth name. "+
-                "Set the auth_name value in the configuration or use the GRAYLOG_AUTH_NAME environment variable. "+
-                "If either is already set, ensure the value is not empty.",
+            path.Root("auth_mode"),
+            "Invalid Graylog API Auth Mode",
+            "The provider cannot create the Graylog API client as the Graylog API auth mode must be one of basic, token or session, got: "+auth_mode+". "+
+                "Check the auth_mode value in the configuration or the GRAYLOG_AUTH_MODE environment variable.",
         )
     }
 
-    if auth_password == "" {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("auth_password"),
-            "Missing Graylog API Auth Password",
-            "The provider cannot create the Graylog API client as there is a missing or empty value for the Graylog API auth password. "+
-                "Set the auth_password value in the configuration or use the GRAYLOG_AUTH_PASSWORD environment variable. "+
-                "If either is already set, ensure the value is not empty.",
+    // Parse the retry settings, keeping the client defaults when unset
+    max_attempts := client.DefaultRetryMaxAttempts
+    if retry_max_attempts != "" {
+        parsed, err := strconv.Atoi(retry_max_attempts)
+        if err != nil || parsed < 1 {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("retry_max_attempts"),
+                "Invalid Graylog Retry Max Attempts",
+                "The provider cannot create the Graylog API client as the Graylog retry max attempts must be a whole number of at least 1, got: "+retry_max_attempts+". "+
+                    "Check the retry_max_attempts value in the configuration or the GRAYLOG_RETRY_MAX_ATTEMPTS environment variable.",
+            )
+        }
+        max_attempts = parsed
+    }
+
+    max_duration := client.DefaultRetryMaxDuration
+    if retry_max_duration != "" {
+        parsed, err := time.ParseDuration(retry_max_duration)
+        if err != nil || parsed <= 0 {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("retry_max_duration"),
+                "Invalid Graylog Retry Max Duration",
+                "The provider cannot create the Graylog API client as the Graylog retry max duration must be a positive duration like 90s or 5m, got: "+retry_max_duration+". "+
+                    "Check the retry_max_duration value in the configuration or the GRAYLOG_RETRY_MAX_DURATION environment variable.",
+            )
+        }
+        max_duration = parsed
+    }
+
+    insecure_skip_verify := false
+    if tls_insecure_skip_verify != "" {
+        parsed, err := strconv.ParseBool(tls_insecure_skip_verify)
+        if err != nil {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("tls_insecure_skip_verify"),
+                "Invalid Graylog TLS Insecure Skip Verify",
+                "The provider cannot create the Graylog API client as the Graylog TLS insecure skip verify must be true or false, got: "+tls_insecure_skip_verify+". "+
+                    "Check the tls_insecure_skip_verify value in the configuration or the GRAYLOG_TLS_INSECURE_SKIP_VERIFY environment variable.",
+            )
+        }
+        insecure_skip_verify = parsed
+    }
+
+    // Build the TLS and proxy settings of the HTTP transport
+    transport, err := client.NewTransport(&client.TransportConfig{
+        CACertPEM:          ca_cert_pem,
+        CACertFile:         ca_cert_file,
+        ClientCertPEM:      client_cert,
+        ClientKeyPEM:       client_key,
+        ServerName:         tls_server_name,
+        InsecureSkipVerify: insecure_skip_verify,
+        ProxyURL:           proxy_url,
+    })
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Invalid Graylog TLS or Proxy Configuration",
+            "The provider cannot create the Graylog API client as the TLS or proxy settings are invalid. "+
+                "Check the ca_cert_pem, ca_cert_file, client_cert, client_key and proxy_url values in the configuration or their environment variables.\n\n"+
+                "Graylog Client Error: "+err.Error(),
         )
     }
 
@@ -228,12 +538,14 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
     ctx = tflog.SetField(ctx, "graylog_endpoint", endpoint)
     ctx = tflog.SetField(ctx, "graylog_auth_name", auth_name)
     ctx = tflog.SetField(ctx, "graylog_auth_password", auth_password)
-    ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "graylog_auth_password")
+    ctx = tflog.SetField(ctx, "graylog_auth_token", auth_token)
+    ctx = tflog.SetField(ctx, "graylog_auth_mode", auth_mode)
+    ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "graylog_auth_password", "graylog_auth_token")
 
     tflog.Debug(ctx, "Creating Graylog client")
 
     // Create a new Graylog client using the configuration values
-    client, err := client.NewClient(&endpoint, &auth_name, &auth_password)
+    client, err := newClient(auth_mode, endpoint, auth_name, auth_password, auth_token)
     if err != nil {
         resp.Diagnostics.AddError(
             "Unable to Create Graylog API Client",
@@ -251,19 +563,41 @@ func (p *graylogProvider) Configure(ctx context.Context, req provider.ConfigureR
     if api_version != "" {
         client.SetAPIVersion(api_version)
     }
+    client.SetRetry(max_attempts, max_duration)
+    client.SetTransport(transport)
 
-    // Make the Graylog client available during DataSource and Resource
-    // type Configure methods.
+    // Make the Graylog client available during DataSource, Resource and
+    // Action type Configure methods.
     resp.DataSourceData = client
     resp.ResourceData = client
+    resp.ActionData = client
 }
 
 
+// newClient creates a Graylog API client authenticating with the given mode.
+func newClient(authMode, endpoint, authName, authPassword, authToken string) (*client.Client, error) {
+    switch authMode {
+    case client.AuthModeToken:
+        return client.NewClientWithToken(&endpoint, &authToken)
+    case client.AuthModeSession:
+        return client.NewClientWithSession(&endpoint, &authName, &authPassword)
+    default:
+        return client.NewClient(&endpoint, &authName, &authPassword)
+    }
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *graylogProvider) DataSources(_ context.Context) []func() datasource.DataSource {
   return []func() datasource.DataSource{
     graylogds.NewEventDefinitionDataSource,
     graylogds.NewEventNotificationDataSource,
+    graylogds.NewEventDefinitionsDataSource,
+    graylogds.NewEventNotificationsDataSource,
+    graylogds.NewInputDataSource,
+    graylogds.NewInputsDataSource,
+    graylogds.NewInputTypesDataSource,
+    graylogds.NewIndexSetDataSource,
+    graylogds.NewIndexSetsDataSource,
   }
 }
 
@@ -274,6 +608,32 @@ func (p *graylogProvider) Resources(_ context.Context) []func() resource.Resourc
         graylogres.NewEventDefinitionResource,
         graylogres.NewEventNotificationResource,
         graylogres.NewIndexSetResource,
+        graylogres.NewIndexSetFieldMappingResource,
+        graylogres.NewIndexFieldTypeProfileResource,
         graylogres.NewInputResource,
+        graylogres.NewInputStaticFieldResource,
+        graylogres.NewExtractorResource,
+        graylogres.NewStreamResource,
+        graylogres.NewStreamRuleResource,
+        graylogres.NewPipelineResource,
+        graylogres.NewPipelineRuleResource,
+        graylogres.NewPipelineConnectionResource,
+    }
+}
+
+
+// Actions defines the actions implemented in the provider.
+func (p *graylogProvider) Actions(_ context.Context) []func() action.Action {
+    return []func() action.Action{
+        graylogact.NewIndexSetCycleAction,
+        graylogact.NewIndexRangesRebuildAction,
+    }
+}
+
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *graylogProvider) Functions(_ context.Context) []func() function.Function {
+    return []func() function.Function{
+        graylogfn.NewPipelineRuleValidateFunction,
     }
 }
@@ -0,0 +1,491 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aggregationConfigType is the event processor type of aggregation event definitions.
+const aggregationConfigType = "aggregation-v1"
+
+// aggregationConfigKeys are the config keys owned by the aggregation block.
+var aggregationConfigKeys = []string{
+	"query", "query_parameters", "filters", "streams", "group_by", "series", "conditions",
+	"search_within_ms", "execute_every_ms", "use_cron_scheduling", "cron_expression",
+	"cron_timezone", "event_limit",
+}
+
+// eventDefinitionAggregationModel maps the aggregation block.
+type eventDefinitionAggregationModel struct {
+	Query             types.String                    `tfsdk:"query"`
+	Streams           types.Set                       `tfsdk:"streams"`
+	GroupBy           types.List                      `tfsdk:"group_by"`
+	SearchWithinMs    types.Int64                     `tfsdk:"search_within_ms"`
+	ExecuteEveryMs    types.Int64                     `tfsdk:"execute_every_ms"`
+	UseCronScheduling types.Bool                      `tfsdk:"use_cron_scheduling"`
+	CronExpression    types.String                    `tfsdk:"cron_expression"`
+	CronTimezone      types.String                    `tfsdk:"cron_timezone"`
+	EventLimit        types.Int64                     `tfsdk:"event_limit"`
+	Series            []eventDefinitionSeriesModel    `tfsdk:"series"`
+	Conditions        *eventDefinitionConditionsModel `tfsdk:"conditions"`
+}
+
+// eventDefinitionSeriesModel maps a series block.
+type eventDefinitionSeriesModel struct {
+	ID       types.String `tfsdk:"id"`
+	Function types.String `tfsdk:"function"`
+	Field    types.String `tfsdk:"field"`
+}
+
+// eventDefinitionConditionsModel maps the conditions block.
+type eventDefinitionConditionsModel struct {
+	Operator    types.String                    `tfsdk:"operator"`
+	Expressions []eventDefinitionConditionModel `tfsdk:"expression"`
+}
+
+// eventDefinitionConditionModel maps a single comparison of a series result.
+type eventDefinitionConditionModel struct {
+	SeriesID   types.String  `tfsdk:"series_id"`
+	Comparator types.String  `tfsdk:"comparator"`
+	Value      types.Float64 `tfsdk:"value"`
+}
+
+// aggregationBlock returns the schema of the aggregation block.
+func aggregationBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Typed configuration of an 'aggregation-v1' event definition. Requires config_type to be 'aggregation-v1'.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "The search query messages must match.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"streams": schema.SetAttribute{
+				Description: "The IDs of the streams to search in. All streams are searched when empty.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"group_by": schema.ListAttribute{
+				Description: "The fields the aggregation is grouped by.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"search_within_ms": schema.Int64Attribute{
+				Description: "The time range in milliseconds searched on every execution.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60000),
+			},
+			"execute_every_ms": schema.Int64Attribute{
+				Description: "The interval in milliseconds the search is executed at.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60000),
+			},
+			"use_cron_scheduling": schema.BoolAttribute{
+				Description: "Whether the search is scheduled with cron_expression instead of execute_every_ms.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"cron_expression": schema.StringAttribute{
+				Description: "The Quartz cron expression used when use_cron_scheduling is enabled.",
+				Optional:    true,
+			},
+			"cron_timezone": schema.StringAttribute{
+				Description: "The time zone cron_expression is evaluated in.",
+				Optional:    true,
+			},
+			"event_limit": schema.Int64Attribute{
+				Description: "The maximum number of events created per execution.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(100),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"series": schema.ListNestedBlock{
+				Description: "An aggregation function computed over the matching messages.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The identifier conditions use to refer to the series.",
+							Required:    true,
+						},
+						"function": schema.StringAttribute{
+							Description: "The aggregation function (avg, card, count, latest, max, min, percentage, percentile, stddev, sum, sumofsquares or variance).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("avg", "card", "count", "latest", "max", "min", "percentage", "percentile", "stddev", "sum", "sumofsquares", "variance"),
+							},
+						},
+						"field": schema.StringAttribute{
+							Description: "The message field the function is applied to. Not required for count.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"conditions": schema.SingleNestedBlock{
+				Description: "The conditions the series results must meet to create an event. Only comparisons of a series with a number combined with a single boolean operator are supported, conditions mixing '&&' and '||' or using other expressions are reported with a warning and replaced on the next apply.",
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						Description: "The boolean operator combining the expressions ('&&' or '||').",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("&&"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("&&", "||"),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"expression": schema.ListNestedBlock{
+						Description: "A comparison of a series result with a threshold.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"series_id": schema.StringAttribute{
+									Description: "The ID of the series whose result is compared.",
+									Required:    true,
+								},
+								"comparator": schema.StringAttribute{
+									Description: "The comparison operator (>, >=, <, <= or ==).",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(">", ">=", "<", "<=", "=="),
+									},
+								},
+								"value": schema.Float64Attribute{
+									Description: "The threshold the series result is compared with.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateAggregation checks that the aggregation block matches the config type
+// and does not conflict with the generic config map.
+func validateAggregation(config *eventDefinitionResourceModel, diags *diag.Diagnostics) {
+	if config.Aggregation == nil {
+		return
+	}
+
+	if !config.ConfigType.IsUnknown() && config.ConfigType.ValueString() != aggregationConfigType {
+		diags.AddAttributeError(
+			path.Root("aggregation"),
+			"Invalid Aggregation Block",
+			"The aggregation block requires config_type to be '"+aggregationConfigType+"', got: "+config.ConfigType.ValueString(),
+		)
+	}
+
+	if !config.Config.IsNull() && !config.Config.IsUnknown() {
+		for _, key := range aggregationConfigKeys {
+			if _, exists := config.Config.Elements()[key]; exists {
+				diags.AddAttributeError(
+					path.Root("config").AtMapKey(key),
+					"Conflicting Event Definition Config",
+					"The config key '"+key+"' is managed by the aggregation block and cannot be set in config.",
+				)
+			}
+		}
+	}
+
+	aggregation := config.Aggregation
+	if aggregation.UseCronScheduling.ValueBool() && aggregation.CronExpression.IsNull() {
+		diags.AddAttributeError(
+			path.Root("aggregation").AtName("cron_expression"),
+			"Missing Cron Expression",
+			"cron_expression is required when use_cron_scheduling is enabled.",
+		)
+	}
+
+	seriesIDs := make(map[string]bool)
+	for _, series := range aggregation.Series {
+		if !series.ID.IsUnknown() {
+			seriesIDs[series.ID.ValueString()] = true
+		}
+		if series.Field.IsNull() && !series.Function.IsUnknown() && series.Function.ValueString() != "count" {
+			diags.AddAttributeError(
+				path.Root("aggregation").AtName("series"),
+				"Missing Series Field",
+				"The series function '"+series.Function.ValueString()+"' requires a field.",
+			)
+		}
+	}
+	if aggregation.Conditions != nil {
+		for _, expression := range aggregation.Conditions.Expressions {
+			if !expression.SeriesID.IsUnknown() && !seriesIDs[expression.SeriesID.ValueString()] {
+				diags.AddAttributeError(
+					path.Root("aggregation").AtName("conditions"),
+					"Unknown Series ID",
+					"The condition refers to series '"+expression.SeriesID.ValueString()+"', which is not defined by a series block.",
+				)
+			}
+		}
+	}
+}
+
+// aggregationConfig converts the aggregation block into an event definition config.
+func aggregationConfig(ctx context.Context, model *eventDefinitionAggregationModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	streams := []string{}
+	if !model.Streams.IsNull() && !model.Streams.IsUnknown() {
+		diags.Append(model.Streams.ElementsAs(ctx, &streams, false)...)
+	}
+
+	groupBy := []string{}
+	if !model.GroupBy.IsNull() && !model.GroupBy.IsUnknown() {
+		diags.Append(model.GroupBy.ElementsAs(ctx, &groupBy, false)...)
+	}
+
+	series := []interface{}{}
+	for _, s := range model.Series {
+		entry := map[string]interface{}{
+			"id":   s.ID.ValueString(),
+			"type": s.Function.ValueString(),
+		}
+		if !s.Field.IsNull() {
+			entry["field"] = s.Field.ValueString()
+		}
+		series = append(series, entry)
+	}
+
+	config := map[string]interface{}{
+		"type":                aggregationConfigType,
+		"query":               model.Query.ValueString(),
+		"query_parameters":    []interface{}{},
+		"filters":             []interface{}{},
+		"streams":             streams,
+		"group_by":            groupBy,
+		"series":              series,
+		"conditions":          map[string]interface{}{"expression": conditionTree(model.Conditions)},
+		"search_within_ms":    model.SearchWithinMs.ValueInt64(),
+		"execute_every_ms":    model.ExecuteEveryMs.ValueInt64(),
+		"use_cron_scheduling": model.UseCronScheduling.ValueBool(),
+		"cron_expression":     nil,
+		"cron_timezone":       nil,
+		"event_limit":         model.EventLimit.ValueInt64(),
+	}
+	if !model.CronExpression.IsNull() {
+		config["cron_expression"] = model.CronExpression.ValueString()
+	}
+	if !model.CronTimezone.IsNull() {
+		config["cron_timezone"] = model.CronTimezone.ValueString()
+	}
+
+	return config, diags
+}
+
+// conditionTree builds the Graylog expression tree for the conditions block by
+// folding the expressions with the configured boolean operator.
+func conditionTree(conditions *eventDefinitionConditionsModel) interface{} {
+	if conditions == nil || len(conditions.Expressions) == 0 {
+		return nil
+	}
+
+	var tree map[string]interface{}
+	for _, expression := range conditions.Expressions {
+		leaf := map[string]interface{}{
+			"expr": expression.Comparator.ValueString(),
+			"left": map[string]interface{}{
+				"expr": "number-ref",
+				"ref":  expression.SeriesID.ValueString(),
+			},
+			"right": map[string]interface{}{
+				"expr":  "number",
+				"value": expression.Value.ValueFloat64(),
+			},
+		}
+		if tree == nil {
+			tree = leaf
+			continue
+		}
+		tree = map[string]interface{}{
+			"expr":  conditions.Operator.ValueString(),
+			"left":  tree,
+			"right": leaf,
+		}
+	}
+
+	return tree
+}
+
+// aggregationModelFromConfig converts an event definition config into the aggregation block.
+func aggregationModelFromConfig(ctx context.Context, config map[string]interface{}) (*eventDefinitionAggregationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := &eventDefinitionAggregationModel{
+		Query:             types.StringValue(stringFromConfig(config["query"])),
+		SearchWithinMs:    types.Int64Value(int64FromConfig(config["search_within_ms"])),
+		ExecuteEveryMs:    types.Int64Value(int64FromConfig(config["execute_every_ms"])),
+		UseCronScheduling: types.BoolValue(config["use_cron_scheduling"] == true),
+		CronExpression:    types.StringNull(),
+		CronTimezone:      types.StringNull(),
+		EventLimit:        types.Int64Value(int64FromConfig(config["event_limit"])),
+		Series:            []eventDefinitionSeriesModel{},
+		Streams:           types.SetNull(types.StringType),
+		GroupBy:           types.ListNull(types.StringType),
+	}
+	if value, ok := config["cron_expression"].(string); ok {
+		model.CronExpression = types.StringValue(value)
+	}
+	if value, ok := config["cron_timezone"].(string); ok {
+		model.CronTimezone = types.StringValue(value)
+	}
+
+	if streams := stringsFromConfig(config["streams"]); len(streams) > 0 {
+		value, d := types.SetValueFrom(ctx, types.StringType, streams)
+		diags.Append(d...)
+		model.Streams = value
+	}
+	if groupBy := stringsFromConfig(config["group_by"]); len(groupBy) > 0 {
+		value, d := types.ListValueFrom(ctx, types.StringType, groupBy)
+		diags.Append(d...)
+		model.GroupBy = value
+	}
+
+	if series, ok := config["series"].([]interface{}); ok {
+		for _, item := range series {
+			entry, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			function := stringFromConfig(entry["type"])
+			if function == "" {
+				function = stringFromConfig(entry["function"])
+			}
+			s := eventDefinitionSeriesModel{
+				ID:       types.StringValue(stringFromConfig(entry["id"])),
+				Function: types.StringValue(function),
+				Field:    types.StringNull(),
+			}
+			if field, ok := entry["field"].(string); ok {
+				s.Field = types.StringValue(field)
+			}
+			model.Series = append(model.Series, s)
+		}
+	}
+
+	if conditions, ok := config["conditions"].(map[string]interface{}); ok {
+		if expression, ok := conditions["expression"].(map[string]interface{}); ok {
+			model.Conditions = &eventDefinitionConditionsModel{Expressions: []eventDefinitionConditionModel{}}
+			operator := ""
+			if err := flattenConditionTree(expression, &operator, &model.Conditions.Expressions); err != nil {
+				diags.AddWarning(
+					"Unsupported Event Definition Conditions",
+					"The conditions of the event definition cannot be represented by the conditions block: "+err.Error()+". "+
+						"The next apply replaces them with the configured conditions. Simplify the conditions in Graylog to comparisons combined with a single operator, "+
+						"or manage the event definition with config instead of the aggregation block.",
+				)
+				model.Conditions = nil
+				return model, diags
+			}
+			if operator == "" {
+				operator = "&&"
+			}
+			model.Conditions.Operator = types.StringValue(operator)
+		}
+	}
+
+	return model, diags
+}
+
+// flattenConditionTree collects the comparisons of an expression tree that
+// combines them with a single boolean operator.
+func flattenConditionTree(node map[string]interface{}, operator *string, expressions *[]eventDefinitionConditionModel) error {
+	expr := stringFromConfig(node["expr"])
+
+	switch expr {
+	case "group":
+		child, ok := node["child"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("group expression without child")
+		}
+		return flattenConditionTree(child, operator, expressions)
+	case "&&", "||":
+		if *operator != "" && *operator != expr {
+			return fmt.Errorf("mixing '&&' and '||' is not supported")
+		}
+		*operator = expr
+		for _, side := range []string{"left", "right"} {
+			child, ok := node[side].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("'%s' expression without %s operand", expr, side)
+			}
+			if err := flattenConditionTree(child, operator, expressions); err != nil {
+				return err
+			}
+		}
+		return nil
+	case ">", ">=", "<", "<=", "==":
+		left, _ := node["left"].(map[string]interface{})
+		right, _ := node["right"].(map[string]interface{})
+		if stringFromConfig(left["expr"]) != "number-ref" || stringFromConfig(right["expr"]) != "number" {
+			return fmt.Errorf("only comparisons of a series with a number are supported")
+		}
+		value, ok := right["value"].(float64)
+		if !ok {
+			return fmt.Errorf("comparison without a numeric value")
+		}
+		*expressions = append(*expressions, eventDefinitionConditionModel{
+			SeriesID:   types.StringValue(stringFromConfig(left["ref"])),
+			Comparator: types.StringValue(expr),
+			Value:      types.Float64Value(value),
+		})
+		return nil
+	}
+
+	return fmt.Errorf("unsupported expression %q", expr)
+}
+
+// stringFromConfig returns a config value as string, or "" when it is not one.
+func stringFromConfig(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
+
+// int64FromConfig returns a numeric config value as int64.
+func int64FromConfig(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	}
+	return 0
+}
+
+// stringsFromConfig returns a list config value as strings.
+func stringsFromConfig(value interface{}) []string {
+	items, _ := value.([]interface{})
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
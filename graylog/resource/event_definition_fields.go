@@ -0,0 +1,260 @@
+package resource
+
+import (
+	"context"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fieldProviderTypes maps the provider types of the field block to the
+// Graylog field value provider types.
+var fieldProviderTypes = map[string]string{
+	"template":     "template-v1",
+	"lookup_table": "lookup-v1",
+}
+
+// eventDefinitionFieldModel maps a field block.
+type eventDefinitionFieldModel struct {
+	Name     types.String                       `tfsdk:"name"`
+	DataType types.String                       `tfsdk:"data_type"`
+	Provider *eventDefinitionFieldProviderModel `tfsdk:"provider"`
+}
+
+// eventDefinitionFieldProviderModel maps the provider block of a field.
+type eventDefinitionFieldProviderModel struct {
+	Type          types.String `tfsdk:"type"`
+	Template      types.String `tfsdk:"template"`
+	RequireValues types.Bool   `tfsdk:"require_values"`
+	TableName     types.String `tfsdk:"table_name"`
+	KeyField      types.String `tfsdk:"key_field"`
+}
+
+// fieldBlock returns the schema of the field block.
+func fieldBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "A custom field added to the events created by the event definition.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the event field.",
+					Required:    true,
+				},
+				"data_type": schema.StringAttribute{
+					Description: "The data type of the event field.",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("string"),
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"provider": schema.SingleNestedBlock{
+					Description: "How the value of the event field is computed.",
+					Validators: []validator.Object{
+						objectvalidator.IsRequired(),
+					},
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The value provider type ('template' or 'lookup_table').",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("template", "lookup_table"),
+							},
+						},
+						"template": schema.StringAttribute{
+							Description: "The template rendering the value, e.g. '${source.source}'. Used by the 'template' type.",
+							Optional:    true,
+						},
+						"require_values": schema.BoolAttribute{
+							Description: "Whether the template fails when a referenced value is missing. Used by the 'template' type.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"table_name": schema.StringAttribute{
+							Description: "The name of the lookup table. Used by the 'lookup_table' type.",
+							Optional:    true,
+						},
+						"key_field": schema.StringAttribute{
+							Description: "The message field used as lookup key. Used by the 'lookup_table' type.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateFieldSpec checks the field blocks and that every key_spec entry
+// refers to a defined field.
+func validateFieldSpec(config *eventDefinitionResourceModel, diags *diag.Diagnostics) {
+	names := make(map[string]bool)
+	for i, field := range config.Fields {
+		fieldPath := path.Root("field").AtListIndex(i)
+		if !field.Name.IsUnknown() {
+			if names[field.Name.ValueString()] {
+				diags.AddAttributeError(
+					fieldPath.AtName("name"),
+					"Duplicate Event Field",
+					"The event field '"+field.Name.ValueString()+"' is defined more than once.",
+				)
+			}
+			names[field.Name.ValueString()] = true
+		}
+
+		provider := field.Provider
+		if provider == nil || provider.Type.IsUnknown() {
+			continue
+		}
+		switch provider.Type.ValueString() {
+		case "":
+			diags.AddAttributeError(
+				fieldPath.AtName("provider").AtName("type"),
+				"Missing Field Provider Type",
+				"The provider block requires a type.",
+			)
+		case "template":
+			if provider.Template.IsNull() {
+				diags.AddAttributeError(
+					fieldPath.AtName("provider").AtName("template"),
+					"Missing Field Template",
+					"The 'template' provider requires template to be set.",
+				)
+			}
+		case "lookup_table":
+			if provider.TableName.IsNull() || provider.KeyField.IsNull() {
+				diags.AddAttributeError(
+					fieldPath.AtName("provider"),
+					"Missing Lookup Table Settings",
+					"The 'lookup_table' provider requires table_name and key_field to be set.",
+				)
+			}
+		}
+	}
+
+	if config.KeySpec.IsNull() || config.KeySpec.IsUnknown() {
+		return
+	}
+	for i, key := range config.KeySpec.Elements() {
+		value, ok := key.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if !names[value.ValueString()] {
+			diags.AddAttributeError(
+				path.Root("key_spec").AtListIndex(i),
+				"Unknown Key Field",
+				"The key '"+value.ValueString()+"' does not refer to a field defined by a field block.",
+			)
+		}
+	}
+}
+
+// eventDefinitionFieldSpec converts the field blocks and key_spec into the
+// Graylog field and key specifications.
+func eventDefinitionFieldSpec(ctx context.Context, plan *eventDefinitionResourceModel) (map[string]client.EventFieldSpec, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fieldSpec := make(map[string]client.EventFieldSpec)
+	for _, field := range plan.Fields {
+		var providers []client.EventFieldValueProvider
+		if field.Provider != nil {
+			provider := client.EventFieldValueProvider{
+				Type: fieldProviderTypes[field.Provider.Type.ValueString()],
+			}
+			switch field.Provider.Type.ValueString() {
+			case "template":
+				requireValues := field.Provider.RequireValues.ValueBool()
+				provider.Template = field.Provider.Template.ValueString()
+				provider.RequireValues = &requireValues
+			case "lookup_table":
+				provider.TableName = field.Provider.TableName.ValueString()
+				provider.KeyField = field.Provider.KeyField.ValueString()
+			}
+			providers = append(providers, provider)
+		}
+
+		fieldSpec[field.Name.ValueString()] = client.EventFieldSpec{
+			DataType:  field.DataType.ValueString(),
+			Providers: providers,
+		}
+	}
+
+	keySpec := []string{}
+	if !plan.KeySpec.IsNull() && !plan.KeySpec.IsUnknown() {
+		diags.Append(plan.KeySpec.ElementsAs(ctx, &keySpec, false)...)
+	}
+
+	return fieldSpec, keySpec, diags
+}
+
+// fieldModelsFromSpec converts the Graylog field specification into field
+// blocks, keeping the order of the fields tracked in state.
+func fieldModelsFromSpec(fieldSpec map[string]client.EventFieldSpec, tracked []eventDefinitionFieldModel) []eventDefinitionFieldModel {
+	names := make([]string, 0, len(fieldSpec))
+	seen := make(map[string]bool)
+	for _, field := range tracked {
+		name := field.Name.ValueString()
+		if _, exists := fieldSpec[name]; exists && !seen[name] {
+			names = append(names, name)
+			seen[name] = true
+		}
+	}
+	var remaining []string
+	for name := range fieldSpec {
+		if !seen[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	sort.Strings(remaining)
+	names = append(names, remaining...)
+
+	fields := []eventDefinitionFieldModel{}
+	for _, name := range names {
+		spec := fieldSpec[name]
+		field := eventDefinitionFieldModel{
+			Name:     types.StringValue(name),
+			DataType: types.StringValue(spec.DataType),
+		}
+
+		if len(spec.Providers) > 0 {
+			provider := spec.Providers[0]
+			model := &eventDefinitionFieldProviderModel{
+				Type:          types.StringValue(provider.Type),
+				Template:      types.StringNull(),
+				RequireValues: types.BoolValue(provider.RequireValues != nil && *provider.RequireValues),
+				TableName:     types.StringNull(),
+				KeyField:      types.StringNull(),
+			}
+			for blockType, apiType := range fieldProviderTypes {
+				if apiType == provider.Type {
+					model.Type = types.StringValue(blockType)
+				}
+			}
+			if provider.Template != "" {
+				model.Template = types.StringValue(provider.Template)
+			}
+			if provider.TableName != "" {
+				model.TableName = types.StringValue(provider.TableName)
+			}
+			if provider.KeyField != "" {
+				model.KeyField = types.StringValue(provider.KeyField)
+			}
+			field.Provider = model
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
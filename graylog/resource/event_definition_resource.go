@@ -6,20 +6,26 @@ import (
 	"strconv"
 
 	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &eventDefinitionResource{}
-	_ resource.ResourceWithConfigure   = &eventDefinitionResource{}
-	_ resource.ResourceWithImportState = &eventDefinitionResource{}
+	_ resource.Resource                   = &eventDefinitionResource{}
+	_ resource.ResourceWithConfigure      = &eventDefinitionResource{}
+	_ resource.ResourceWithImportState    = &eventDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &eventDefinitionResource{}
 )
 
 // NewEventDefinitionResource is a helper function to simplify the provider implementation.
@@ -34,15 +40,21 @@ type eventDefinitionResource struct {
 
 // eventDefinitionResourceModel maps the resource schema data.
 type eventDefinitionResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Title              types.String `tfsdk:"title"`
-	Description        types.String `tfsdk:"description"`
-	Priority           types.Int64  `tfsdk:"priority"`
-	ConfigType         types.String `tfsdk:"config_type"`
-	Config             types.Map    `tfsdk:"config"`
-	GracePeriodMs      types.Int64  `tfsdk:"grace_period_ms"`
-	BacklogSize        types.Int64  `tfsdk:"backlog_size"`
-	NotificationIds    types.List   `tfsdk:"notification_ids"`
+	ID              types.String                     `tfsdk:"id"`
+	Title           types.String                     `tfsdk:"title"`
+	Description     types.String                     `tfsdk:"description"`
+	Priority        types.Int64                      `tfsdk:"priority"`
+	ConfigType      types.String                     `tfsdk:"config_type"`
+	Config          types.Map                        `tfsdk:"config"`
+	GracePeriodMs   types.Int64                      `tfsdk:"grace_period_ms"`
+	BacklogSize     types.Int64                      `tfsdk:"backlog_size"`
+	NotificationIds types.List                       `tfsdk:"notification_ids"`
+	KeySpec         types.List                       `tfsdk:"key_spec"`
+	Alert           types.Bool                       `tfsdk:"alert"`
+	Storage         types.List                       `tfsdk:"storage"`
+	Aggregation     *eventDefinitionAggregationModel `tfsdk:"aggregation"`
+	Fields          []eventDefinitionFieldModel      `tfsdk:"field"`
+	Timeouts        timeouts.Value                   `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -51,7 +63,7 @@ func (r *eventDefinitionResource) Metadata(_ context.Context, req resource.Metad
 }
 
 // Schema defines the schema for the resource.
-func (r *eventDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *eventDefinitionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Graylog event definition.",
 		Attributes: map[string]schema.Attribute{
@@ -75,7 +87,7 @@ func (r *eventDefinitionResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 			},
 			"config_type": schema.StringAttribute{
-				Description: "The type of event processor configuration.",
+				Description: "The type of event processor configuration, e.g. 'aggregation-v1'.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -105,6 +117,44 @@ func (r *eventDefinitionResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"alert": schema.BoolAttribute{
+				Description: "Whether events of this definition are alerts. Defaults to true when notification_ids is not empty.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"storage": schema.ListNestedAttribute{
+				Description: "Where the events are persisted. Defaults to the storage chosen by Graylog.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The storage handler type.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("persist-to-streams-v1"),
+						},
+						"streams": schema.ListAttribute{
+							Description: "The IDs of the streams the events are written to.",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"key_spec": schema.ListAttribute{
+				Description: "The names of the event fields used as event key, e.g. to deduplicate events. Every key must be defined by a field block.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"aggregation": aggregationBlock(),
+			"field":       fieldBlock(),
+			"timeouts":    timeoutsBlock(ctx),
 		},
 	}
 }
@@ -127,6 +177,19 @@ func (r *eventDefinitionResource) Configure(_ context.Context, req resource.Conf
 	r.client = client
 }
 
+// ValidateConfig checks the typed blocks against each other and the config type.
+func (r *eventDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config eventDefinitionResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateAggregation(&config, &resp.Diagnostics)
+	validateFieldSpec(&config, &resp.Diagnostics)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *eventDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan eventDefinitionResourceModel
@@ -136,59 +199,34 @@ func (r *eventDefinitionResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Build the create request with proper config based on type
-	config := map[string]interface{}{
-		"type": plan.ConfigType.ValueString(),
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	// Add config from plan if provided
-	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
-		configMap := make(map[string]string)
-		diags = plan.Config.ElementsAs(ctx, &configMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
 
-		for key, value := range configMap {
-			if value == "true" {
-				config[key] = true
-			} else if value == "false" {
-				config[key] = false
-			} else if intVal, err := strconv.Atoi(value); err == nil {
-				config[key] = intVal
-			} else {
-				config[key] = value
-			}
-		}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the event processor config
+	config, diags := eventDefinitionConfig(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	// Add required fields for aggregation-v1 type if not already set
-	if plan.ConfigType.ValueString() == "aggregation-v1" {
-		if _, exists := config["query"]; !exists {
-			config["query"] = ""
-		}
-		if _, exists := config["streams"]; !exists {
-			config["streams"] = []interface{}{}
-		}
-		if _, exists := config["group_by"]; !exists {
-			config["group_by"] = []interface{}{}
-		}
-		if _, exists := config["series"]; !exists {
-			config["series"] = []interface{}{}
-		}
-		if _, exists := config["conditions"]; !exists {
-			config["conditions"] = map[string]interface{}{}
-		}
-		if _, exists := config["search_within_ms"]; !exists {
-			config["search_within_ms"] = 60000
-		}
-		if _, exists := config["execute_every_ms"]; !exists {
-			config["execute_every_ms"] = 60000
-		}
-		if _, exists := config["event_limit"]; !exists {
-			config["event_limit"] = 1
-		}
+
+	// Build the alert flag and event storage
+	alert, storage, diags := eventDefinitionAlertAndStorage(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the custom event fields
+	fieldSpec, keySpec, diags := eventDefinitionFieldSpec(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Build notification settings
@@ -213,27 +251,31 @@ func (r *eventDefinitionResource) Create(ctx context.Context, req resource.Creat
 			}
 		}
 	}
-	
+	if alert == nil {
+		inferred := len(notifications) > 0
+		alert = &inferred
+	}
+
 	createReq := &client.CreateEventDefinitionRequest{
 		Entity: client.EventDefinitionEntity{
 			Title:       plan.Title.ValueString(),
 			Description: plan.Description.ValueString(),
 			Priority:    int(plan.Priority.ValueInt64()),
-			Alert:       len(notifications) > 0,
+			Alert:       *alert,
 			Config:      config,
 			NotificationSettings: client.NotificationSettings{
 				GracePeriodMs: int(gracePeriod),
 				BacklogSize:   int(backlog),
 			},
-			FieldSpec:     map[string]interface{}{},
-			KeySpec:       []interface{}{},
+			FieldSpec:     fieldSpec,
+			KeySpec:       keySpec,
 			Notifications: notifications,
-			Storage:       []client.Storage{},
+			Storage:       storage,
 		},
 	}
 
 	// Create the event definition
-	eventDef, err := r.client.CreateEventDefinition(createReq)
+	eventDef, err := r.client.CreateEventDefinition(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Event Definition",
@@ -249,6 +291,15 @@ func (r *eventDefinitionResource) Create(ctx context.Context, req resource.Creat
 	plan.Priority = types.Int64Value(int64(eventDef.Priority))
 	plan.GracePeriodMs = types.Int64Value(int64(eventDef.NotificationSettings.GracePeriodMs))
 	plan.BacklogSize = types.Int64Value(int64(eventDef.NotificationSettings.BacklogSize))
+	if plan.Config.IsUnknown() {
+		plan.Config = types.MapNull(types.StringType)
+	}
+	plan.Alert = types.BoolValue(eventDef.Alert)
+	if plan.Storage.IsUnknown() {
+		storageValue, diags := eventDefinitionStorageValue(ctx, eventDef.Storage)
+		resp.Diagnostics.Append(diags...)
+		plan.Storage = storageValue
+	}
 
 	// Convert notifications to list
 	if len(eventDef.Notifications) > 0 {
@@ -277,9 +328,22 @@ func (r *eventDefinitionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get event definition from API
-	eventDef, err := r.client.GetEventDefinition(state.ID.ValueString())
+	eventDef, err := r.client.GetEventDefinition(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Event Definition",
 			"Could not read event definition ID "+state.ID.ValueString()+": "+err.Error(),
@@ -288,6 +352,7 @@ func (r *eventDefinitionResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Update state
+	imported := state.Title.IsNull()
 	state.Title = types.StringValue(eventDef.Title)
 	state.Description = types.StringValue(eventDef.Description)
 	state.Priority = types.Int64Value(int64(eventDef.Priority))
@@ -316,6 +381,44 @@ func (r *eventDefinitionResource) Read(ctx context.Context, req resource.ReadReq
 		}
 	}
 
+	if configType, ok := eventDef.Config["type"].(string); ok {
+		state.ConfigType = types.StringValue(configType)
+	}
+
+	// Map the aggregation block when it is managed or the resource was imported
+	if state.ConfigType.ValueString() == aggregationConfigType && (state.Aggregation != nil || imported) {
+		aggregation, diags := aggregationModelFromConfig(ctx, eventDef.Config)
+		resp.Diagnostics.Append(diags...)
+		if state.Aggregation != nil {
+			// Keep the configured conditions when Graylog holds a tree the block cannot express
+			if aggregation.Conditions == nil && diags.WarningsCount() > 0 {
+				aggregation.Conditions = state.Aggregation.Conditions
+			}
+			// Graylog does not distinguish empty from unset lists
+			if aggregation.Streams.IsNull() && !state.Aggregation.Streams.IsNull() && len(state.Aggregation.Streams.Elements()) == 0 {
+				aggregation.Streams = state.Aggregation.Streams
+			}
+			if aggregation.GroupBy.IsNull() && !state.Aggregation.GroupBy.IsNull() && len(state.Aggregation.GroupBy.Elements()) == 0 {
+				aggregation.GroupBy = state.Aggregation.GroupBy
+			}
+		}
+		state.Aggregation = aggregation
+	}
+
+	// Update the alert flag and event storage
+	state.Alert = types.BoolValue(eventDef.Alert)
+	storageValue, diags := eventDefinitionStorageValue(ctx, eventDef.Storage)
+	resp.Diagnostics.Append(diags...)
+	state.Storage = storageValue
+
+	// Update custom event fields and keys
+	state.Fields = fieldModelsFromSpec(eventDef.FieldSpec, state.Fields)
+	if len(eventDef.KeySpec) > 0 || !state.KeySpec.IsNull() {
+		keySpec, diags := types.ListValueFrom(ctx, types.StringType, eventDef.KeySpec)
+		resp.Diagnostics.Append(diags...)
+		state.KeySpec = keySpec
+	}
+
 	// Update notification IDs - always sync from API
 	if len(eventDef.Notifications) > 0 {
 		notifIds := make([]string, 0, len(eventDef.Notifications))
@@ -350,59 +453,34 @@ func (r *eventDefinitionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Build the update request with proper config based on type
-	config := map[string]interface{}{
-		"type": plan.ConfigType.ValueString(),
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	// Add config from plan if provided
-	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
-		configMap := make(map[string]string)
-		diags = plan.Config.ElementsAs(ctx, &configMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
 
-		for key, value := range configMap {
-			if value == "true" {
-				config[key] = true
-			} else if value == "false" {
-				config[key] = false
-			} else if intVal, err := strconv.Atoi(value); err == nil {
-				config[key] = intVal
-			} else {
-				config[key] = value
-			}
-		}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Build the event processor config
+	config, diags := eventDefinitionConfig(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	// Add required fields for aggregation-v1 type if not already set
-	if plan.ConfigType.ValueString() == "aggregation-v1" {
-		if _, exists := config["query"]; !exists {
-			config["query"] = ""
-		}
-		if _, exists := config["streams"]; !exists {
-			config["streams"] = []interface{}{}
-		}
-		if _, exists := config["group_by"]; !exists {
-			config["group_by"] = []interface{}{}
-		}
-		if _, exists := config["series"]; !exists {
-			config["series"] = []interface{}{}
-		}
-		if _, exists := config["conditions"]; !exists {
-			config["conditions"] = map[string]interface{}{}
-		}
-		if _, exists := config["search_within_ms"]; !exists {
-			config["search_within_ms"] = 60000
-		}
-		if _, exists := config["execute_every_ms"]; !exists {
-			config["execute_every_ms"] = 60000
-		}
-		if _, exists := config["event_limit"]; !exists {
-			config["event_limit"] = 1
-		}
+
+	// Build the alert flag and event storage
+	alert, storage, diags := eventDefinitionAlertAndStorage(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the custom event fields
+	fieldSpec, keySpec, diags := eventDefinitionFieldSpec(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Build notification settings
@@ -427,26 +505,30 @@ func (r *eventDefinitionResource) Update(ctx context.Context, req resource.Updat
 			}
 		}
 	}
-	
+	if alert == nil {
+		inferred := len(notifications) > 0
+		alert = &inferred
+	}
+
 	updateReq := &client.UpdateEventDefinitionRequest{
 		ID:          plan.ID.ValueString(),
 		Title:       plan.Title.ValueString(),
 		Description: plan.Description.ValueString(),
 		Priority:    int(plan.Priority.ValueInt64()),
-		Alert:       len(notifications) > 0,
+		Alert:       *alert,
 		Config:      config,
 		NotificationSettings: client.NotificationSettings{
 			GracePeriodMs: int(gracePeriod),
 			BacklogSize:   int(backlog),
 		},
-		FieldSpec:     map[string]interface{}{},
-		KeySpec:       []interface{}{},
+		FieldSpec:     fieldSpec,
+		KeySpec:       keySpec,
 		Notifications: notifications,
-		Storage:       []client.Storage{},
+		Storage:       storage,
 	}
 
 	// Update the event definition
-	eventDef, err := r.client.UpdateEventDefinition(plan.ID.ValueString(), updateReq)
+	eventDef, err := r.client.UpdateEventDefinition(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Event Definition",
@@ -461,6 +543,15 @@ func (r *eventDefinitionResource) Update(ctx context.Context, req resource.Updat
 	plan.Priority = types.Int64Value(int64(eventDef.Priority))
 	plan.GracePeriodMs = types.Int64Value(int64(eventDef.NotificationSettings.GracePeriodMs))
 	plan.BacklogSize = types.Int64Value(int64(eventDef.NotificationSettings.BacklogSize))
+	if plan.Config.IsUnknown() {
+		plan.Config = types.MapNull(types.StringType)
+	}
+	plan.Alert = types.BoolValue(eventDef.Alert)
+	if plan.Storage.IsUnknown() {
+		storageValue, diags := eventDefinitionStorageValue(ctx, eventDef.Storage)
+		resp.Diagnostics.Append(diags...)
+		plan.Storage = storageValue
+	}
 
 	// Convert notifications to list
 	if len(eventDef.Notifications) > 0 {
@@ -488,9 +579,22 @@ func (r *eventDefinitionResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the event definition
-	err := r.client.DeleteEventDefinition(state.ID.ValueString())
+	err := r.client.DeleteEventDefinition(ctx, state.ID.ValueString())
 	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Event Definition",
 			"Could not delete event definition, unexpected error: "+err.Error(),
@@ -504,3 +608,136 @@ func (r *eventDefinitionResource) ImportState(ctx context.Context, req resource.
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// eventDefinitionConfig builds the event processor config from the typed
+// aggregation block or the generic config map.
+func eventDefinitionConfig(ctx context.Context, plan *eventDefinitionResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := map[string]interface{}{
+		"type": plan.ConfigType.ValueString(),
+	}
+
+	if plan.Aggregation != nil {
+		aggregation, d := aggregationConfig(ctx, plan.Aggregation)
+		diags.Append(d...)
+		for key, value := range aggregation {
+			config[key] = value
+		}
+	}
+
+	// Add config from plan if provided
+	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
+		configMap := make(map[string]string)
+		diags.Append(plan.Config.ElementsAs(ctx, &configMap, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for key, value := range configMap {
+			if value == "true" {
+				config[key] = true
+			} else if value == "false" {
+				config[key] = false
+			} else if intVal, err := strconv.Atoi(value); err == nil {
+				config[key] = intVal
+			} else {
+				config[key] = value
+			}
+		}
+	}
+
+	// Add required fields for aggregation-v1 type if not already set
+	if plan.Aggregation == nil && plan.ConfigType.ValueString() == aggregationConfigType {
+		if _, exists := config["query"]; !exists {
+			config["query"] = ""
+		}
+		if _, exists := config["streams"]; !exists {
+			config["streams"] = []interface{}{}
+		}
+		if _, exists := config["group_by"]; !exists {
+			config["group_by"] = []interface{}{}
+		}
+		if _, exists := config["series"]; !exists {
+			config["series"] = []interface{}{}
+		}
+		if _, exists := config["conditions"]; !exists {
+			config["conditions"] = map[string]interface{}{}
+		}
+		if _, exists := config["search_within_ms"]; !exists {
+			config["search_within_ms"] = 60000
+		}
+		if _, exists := config["execute_every_ms"]; !exists {
+			config["execute_every_ms"] = 60000
+		}
+		if _, exists := config["event_limit"]; !exists {
+			config["event_limit"] = 1
+		}
+	}
+
+	return config, diags
+}
+
+// eventDefinitionStorageAttrTypes describes the objects of the storage attribute.
+var eventDefinitionStorageAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"streams": types.ListType{ElemType: types.StringType},
+}
+
+// eventDefinitionStorageModel maps a storage entry.
+type eventDefinitionStorageModel struct {
+	Type    types.String `tfsdk:"type"`
+	Streams types.List   `tfsdk:"streams"`
+}
+
+// eventDefinitionAlertAndStorage returns the configured alert flag, or nil when
+// it is inferred from the notifications, and the configured event storage.
+func eventDefinitionAlertAndStorage(ctx context.Context, plan *eventDefinitionResourceModel) (*bool, []client.Storage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var alert *bool
+	if !plan.Alert.IsNull() && !plan.Alert.IsUnknown() {
+		value := plan.Alert.ValueBool()
+		alert = &value
+	}
+
+	storage := []client.Storage{}
+	if !plan.Storage.IsNull() && !plan.Storage.IsUnknown() {
+		var entries []eventDefinitionStorageModel
+		diags.Append(plan.Storage.ElementsAs(ctx, &entries, false)...)
+		for _, entry := range entries {
+			var streams []string
+			diags.Append(entry.Streams.ElementsAs(ctx, &streams, false)...)
+			storage = append(storage, client.Storage{
+				Type:    entry.Type.ValueString(),
+				Streams: streams,
+			})
+		}
+	}
+
+	return alert, storage, diags
+}
+
+// eventDefinitionStorageValue converts the event storage returned by Graylog
+// into the storage attribute.
+func eventDefinitionStorageValue(ctx context.Context, storage []client.Storage) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	entries := make([]eventDefinitionStorageModel, 0, len(storage))
+	for _, s := range storage {
+		streams := s.Streams
+		if streams == nil {
+			streams = []string{}
+		}
+		streamsValue, d := types.ListValueFrom(ctx, types.StringType, streams)
+		diags.Append(d...)
+		entries = append(entries, eventDefinitionStorageModel{
+			Type:    types.StringValue(s.Type),
+			Streams: streamsValue,
+		})
+	}
+
+	value, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: eventDefinitionStorageAttrTypes}, entries)
+	diags.Append(d...)
+	return value, diags
+}
@@ -3,9 +3,14 @@ package resource
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 
 	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,9 +21,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &eventNotificationResource{}
-	_ resource.ResourceWithConfigure   = &eventNotificationResource{}
-	_ resource.ResourceWithImportState = &eventNotificationResource{}
+	_ resource.Resource                   = &eventNotificationResource{}
+	_ resource.ResourceWithConfigure      = &eventNotificationResource{}
+	_ resource.ResourceWithImportState    = &eventNotificationResource{}
+	_ resource.ResourceWithValidateConfig = &eventNotificationResource{}
+	_ resource.ResourceWithModifyPlan     = &eventNotificationResource{}
 )
 
 // NewEventNotificationResource is a helper function to simplify the provider implementation.
@@ -33,11 +40,42 @@ type eventNotificationResource struct {
 
 // eventNotificationResourceModel maps the resource schema data.
 type eventNotificationResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Title          types.String `tfsdk:"title"`
-	Description    types.String `tfsdk:"description"`
-	NotificationType types.String `tfsdk:"notification_type"`
-	Config         types.Map    `tfsdk:"config"`
+	ID               types.String   `tfsdk:"id"`
+	Title            types.String   `tfsdk:"title"`
+	Description      types.String   `tfsdk:"description"`
+	NotificationType types.String   `tfsdk:"notification_type"`
+	Config           types.Map      `tfsdk:"config"`
+	HTTP             types.Object   `tfsdk:"http"`
+	Email            types.Object   `tfsdk:"email"`
+	Slack            types.Object   `tfsdk:"slack"`
+	Teams            types.Object   `tfsdk:"teams"`
+	PagerDuty        types.Object   `tfsdk:"pagerduty"`
+	Script           types.Object   `tfsdk:"script"`
+	ConfigWo         types.Map      `tfsdk:"config_wo"`
+	ConfigWoVersion  types.Int64    `tfsdk:"config_wo_version"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// typedBlocks returns the typed notification blocks by block name.
+func (m *eventNotificationResourceModel) typedBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"http":      &m.HTTP,
+		"email":     &m.Email,
+		"slack":     &m.Slack,
+		"teams":     &m.Teams,
+		"pagerduty": &m.PagerDuty,
+		"script":    &m.Script,
+	}
+}
+
+// typedBlock returns the spec and value of the configured typed block, if any.
+func (m *eventNotificationResourceModel) typedBlock() (*notificationTypeSpec, types.Object) {
+	for name, block := range m.typedBlocks() {
+		if !block.IsNull() {
+			return notificationTypeSpecByBlock(name), *block
+		}
+	}
+	return nil, types.ObjectNull(nil)
 }
 
 // Metadata returns the resource type name.
@@ -46,7 +84,7 @@ func (r *eventNotificationResource) Metadata(_ context.Context, req resource.Met
 }
 
 // Schema defines the schema for the resource.
-func (r *eventNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *eventNotificationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Graylog event notification.",
 		Attributes: map[string]schema.Attribute{
@@ -66,22 +104,46 @@ func (r *eventNotificationResource) Schema(_ context.Context, _ resource.SchemaR
 				Optional:    true,
 			},
 			"notification_type": schema.StringAttribute{
-				Description: "The type of notification (e.g., 'http-notification-v1', 'email-notification-v1').",
-				Required:    true,
+				Description: "The type of notification (e.g., 'http-notification-v1', 'email-notification-v1'). Set automatically when a typed block is used.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"config": schema.MapAttribute{
-				Description: "Configuration for the notification. The required attributes vary by notification type.",
+				Description: "Configuration for the notification. The required attributes vary by notification type. Prefer the typed blocks; keys set here are sent as-is.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"config_wo": schema.MapAttribute{
+				Description: "Secret configuration values, sent as encrypted values and never stored in state. Only the encrypted keys of the notification type are supported, i.e. 'basic_auth' and 'api_secret' of HTTP notifications. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+			},
+			"config_wo_version": schema.Int64Attribute{
+				Description: "Version of config_wo. The secrets are only sent to Graylog on create and when this value changes.",
+				Optional:    true,
+			},
 		},
+		Blocks: eventNotificationBlocks(ctx),
 	}
 }
 
+// eventNotificationBlocks returns the schema of the typed notification blocks
+// and the timeouts block.
+func eventNotificationBlocks(ctx context.Context) map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(notificationTypeSpecs)+1)
+	for _, spec := range notificationTypeSpecs {
+		blocks[spec.block] = spec.schemaBlock()
+	}
+	blocks["timeouts"] = timeoutsBlock(ctx)
+	return blocks
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *eventNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -100,6 +162,116 @@ func (r *eventNotificationResource) Configure(_ context.Context, req resource.Co
 	r.client = client
 }
 
+// ValidateConfig checks that at most one typed block is set and that it
+// agrees with notification_type and config.
+func (r *eventNotificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config eventNotificationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ConfigWo.IsNull() && !config.ConfigWo.IsUnknown() && !config.Config.IsNull() && !config.Config.IsUnknown() {
+		for key := range config.ConfigWo.Elements() {
+			if _, exists := config.Config.Elements()[key]; exists {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("config_wo").AtMapKey(key),
+					"Conflicting Notification Config",
+					"The config key '"+key+"' cannot be set in both config and config_wo.",
+				)
+			}
+		}
+	}
+
+	// Only encrypted keys can be sent as encrypted values
+	configType := config.NotificationType
+	if spec, _ := config.typedBlock(); spec != nil {
+		configType = types.StringValue(spec.configType)
+	}
+	validateNotificationSecrets(configType, config.ConfigWo, &resp.Diagnostics)
+
+	var configured []string
+	for name, block := range config.typedBlocks() {
+		if !block.IsNull() {
+			configured = append(configured, name)
+		}
+	}
+	sort.Strings(configured)
+
+	if len(configured) > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting Notification Blocks",
+			"Only one of the http, email, slack, teams, pagerduty and script blocks can be set, got: "+strings.Join(configured, ", "),
+		)
+		return
+	}
+
+	if len(configured) == 0 {
+		if config.NotificationType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("notification_type"),
+				"Missing Notification Type",
+				"Either notification_type or one of the http, email, slack, teams, pagerduty and script blocks must be set.",
+			)
+		}
+		return
+	}
+
+	spec, block := config.typedBlock()
+	if !config.NotificationType.IsNull() && !config.NotificationType.IsUnknown() && config.NotificationType.ValueString() != spec.configType {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_type"),
+			"Conflicting Notification Type",
+			"The "+spec.block+" block configures a '"+spec.configType+"' notification, but notification_type is '"+config.NotificationType.ValueString()+"'.",
+		)
+	}
+
+	if !config.Config.IsNull() && !config.Config.IsUnknown() {
+		for _, a := range spec.attributes {
+			if _, exists := config.Config.Elements()[a.key]; exists {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("config").AtMapKey(a.key),
+					"Conflicting Notification Config",
+					"The config key '"+a.key+"' is managed by the "+spec.block+" block and cannot be set in config.",
+				)
+			}
+		}
+	}
+
+	validateNotificationBlock(spec, block, &resp.Diagnostics)
+}
+
+// ModifyPlan derives notification_type from the typed block and replaces the
+// notification when the type changes.
+func (r *eventNotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan eventNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, _ := plan.typedBlock()
+	if spec == nil || plan.NotificationType.ValueString() == spec.configType {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("notification_type"), spec.configType)...)
+
+	if !req.State.Raw.IsNull() {
+		var notificationType types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("notification_type"), &notificationType)...)
+		if notificationType.ValueString() != spec.configType {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("notification_type"))
+		}
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *eventNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan eventNotificationResourceModel
@@ -109,33 +281,30 @@ func (r *eventNotificationResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	// Build the config based on notification type
-	config := map[string]interface{}{
-		"type": plan.NotificationType.ValueString(),
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Add config from plan if provided
-	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
-		configMap := make(map[string]string)
-		diags = plan.Config.ElementsAs(ctx, &configMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-		for key, value := range configMap {
-			// Convert boolean strings
-			if value == "true" {
-				config[key] = true
-			} else if value == "false" {
-				config[key] = false
-			} else if intVal, err := strconv.Atoi(value); err == nil {
-				// Try to convert to int
-				config[key] = intVal
-			} else {
-				config[key] = value
-			}
-		}
+	// Build the notification config
+	config, diags := eventNotificationConfig(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the write-only secrets
+	secrets, diags := writeOnlyStringMap(ctx, req.Config, path.Root("config_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range encryptedValues(secrets, true) {
+		config[key] = value
 	}
 
 	// Build the create request
@@ -148,7 +317,7 @@ func (r *eventNotificationResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create the event notification
-	notification, err := r.client.CreateEventNotification(createReq)
+	notification, err := r.client.CreateEventNotification(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Event Notification",
@@ -161,6 +330,9 @@ func (r *eventNotificationResource) Create(ctx context.Context, req resource.Cre
 	plan.ID = types.StringValue(notification.ID)
 	plan.Title = types.StringValue(notification.Title)
 	plan.Description = types.StringValue(notification.Description)
+	if plan.Config.IsUnknown() {
+		plan.Config = types.MapNull(types.StringType)
+	}
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -176,9 +348,22 @@ func (r *eventNotificationResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get event notification from API
-	notification, err := r.client.GetEventNotification(state.ID.ValueString())
+	notification, err := r.client.GetEventNotification(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Event Notification",
 			"Could not read event notification ID "+state.ID.ValueString()+": "+err.Error(),
@@ -186,6 +371,9 @@ func (r *eventNotificationResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// Imported resources only know their ID
+	imported := state.Title.IsNull()
+
 	// Update state
 	state.Title = types.StringValue(notification.Title)
 	state.Description = types.StringValue(notification.Description)
@@ -216,6 +404,20 @@ func (r *eventNotificationResource) Read(ctx context.Context, req resource.ReadR
 		}
 	}
 
+	// Update the typed block of the notification type when it is managed or
+	// the resource was imported
+	if configType, ok := notification.Config["type"].(string); ok {
+		state.NotificationType = types.StringValue(configType)
+	}
+	if spec := notificationTypeSpecByConfigType(state.NotificationType.ValueString()); spec != nil {
+		block := state.typedBlocks()[spec.block]
+		if !block.IsNull() || imported {
+			value, diags := spec.object(ctx, notification.Config)
+			resp.Diagnostics.Append(diags...)
+			*block = value
+		}
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -230,33 +432,33 @@ func (r *eventNotificationResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	// Build the config based on notification type
-	config := map[string]interface{}{
-		"type": plan.NotificationType.ValueString(),
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Add config from plan if provided
-	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
-		configMap := make(map[string]string)
-		diags = plan.Config.ElementsAs(ctx, &configMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-		for key, value := range configMap {
-			// Convert boolean strings
-			if value == "true" {
-				config[key] = true
-			} else if value == "false" {
-				config[key] = false
-			} else if intVal, err := strconv.Atoi(value); err == nil {
-				// Try to convert to int
-				config[key] = intVal
-			} else {
-				config[key] = value
-			}
-		}
+	// Build the notification config
+	config, diags := eventNotificationConfig(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the write-only secrets, keeping the stored ones unless the version changed
+	var state eventNotificationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	secrets, diags := writeOnlyStringMap(ctx, req.Config, path.Root("config_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range encryptedValues(secrets, secretsChanged(state.ConfigWoVersion, plan.ConfigWoVersion)) {
+		config[key] = value
 	}
 
 	// Build the update request
@@ -268,7 +470,7 @@ func (r *eventNotificationResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update the event notification
-	notification, err := r.client.UpdateEventNotification(plan.ID.ValueString(), updateReq)
+	notification, err := r.client.UpdateEventNotification(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Event Notification",
@@ -280,6 +482,9 @@ func (r *eventNotificationResource) Update(ctx context.Context, req resource.Upd
 	// Update state
 	plan.Title = types.StringValue(notification.Title)
 	plan.Description = types.StringValue(notification.Description)
+	if plan.Config.IsUnknown() {
+		plan.Config = types.MapNull(types.StringType)
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -294,9 +499,22 @@ func (r *eventNotificationResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete event notification via API
-	err := r.client.DeleteEventNotification(state.ID.ValueString())
+	err := r.client.DeleteEventNotification(ctx, state.ID.ValueString())
 	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Event Notification",
 			"Could not delete event notification, unexpected error: "+err.Error(),
@@ -310,3 +528,122 @@ func (r *eventNotificationResource) ImportState(ctx context.Context, req resourc
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// eventNotificationConfig builds the notification config from the typed block
+// and the generic config map.
+func eventNotificationConfig(ctx context.Context, plan *eventNotificationResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := map[string]interface{}{
+		"type": plan.NotificationType.ValueString(),
+	}
+
+	if spec, block := plan.typedBlock(); spec != nil {
+		typed, d := spec.config(ctx, block)
+		diags.Append(d...)
+		for key, value := range typed {
+			config[key] = value
+		}
+	}
+
+	// Add config from plan if provided
+	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
+		configMap := make(map[string]string)
+		diags.Append(plan.Config.ElementsAs(ctx, &configMap, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for key, value := range configMap {
+			// Convert boolean strings
+			if value == "true" {
+				config[key] = true
+			} else if value == "false" {
+				config[key] = false
+			} else if intVal, err := strconv.Atoi(value); err == nil {
+				// Try to convert to int
+				config[key] = intVal
+			} else {
+				config[key] = value
+			}
+		}
+	}
+
+	return config, diags
+}
+
+// validateNotificationSecrets checks that config_wo only sets the encrypted
+// config keys of the notification type.
+func validateNotificationSecrets(configType types.String, secrets types.Map, diags *diag.Diagnostics) {
+	if configType.IsNull() || configType.IsUnknown() || secrets.IsNull() || secrets.IsUnknown() {
+		return
+	}
+
+	var encryptedKeys []string
+	if spec := notificationTypeSpecByConfigType(configType.ValueString()); spec != nil {
+		encryptedKeys = spec.encryptedKeys
+	}
+
+	for key := range secrets.Elements() {
+		if !slices.Contains(encryptedKeys, key) {
+			supported := "none"
+			if len(encryptedKeys) > 0 {
+				supported = strings.Join(encryptedKeys, ", ")
+			}
+			diags.AddAttributeError(
+				path.Root("config_wo").AtMapKey(key),
+				"Unsupported Notification Secret",
+				"The config key '"+key+"' is not an encrypted value of '"+configType.ValueString()+"' notifications. Supported keys: "+supported+".",
+			)
+		}
+	}
+}
+
+// validateNotificationBlock applies the checks of a typed block that span
+// several attributes.
+func validateNotificationBlock(spec *notificationTypeSpec, block types.Object, diags *diag.Diagnostics) {
+	values := block.Attributes()
+	blockPath := path.Root(spec.block)
+
+	isEmpty := func(name string) bool {
+		switch value := values[name].(type) {
+		case types.String:
+			return !value.IsUnknown() && value.ValueString() == ""
+		case types.List:
+			return !value.IsUnknown() && len(value.Elements()) == 0
+		}
+		return false
+	}
+	isTrue := func(name string) bool {
+		value, ok := values[name].(types.Bool)
+		return ok && value.ValueBool()
+	}
+
+	switch spec.block {
+	case "http":
+		if isTrue("api_key_as_header") && isEmpty("api_key") {
+			diags.AddAttributeError(blockPath.AtName("api_key"), "Missing API Key", "api_key is required when api_key_as_header is enabled.")
+		}
+		// method is null in the config when it is left to its default
+		if method, ok := values["method"].(types.String); ok && !method.IsUnknown() {
+			methodName := method.ValueString()
+			if method.IsNull() {
+				methodName = spec.attribute("method").defaultString
+			}
+			if methodName != "GET" && isEmpty("body_template") {
+				diags.AddAttributeError(blockPath.AtName("body_template"), "Missing Body Template", "body_template is required for "+methodName+" requests.")
+			}
+		}
+	case "email":
+		if isEmpty("recipients") && isEmpty("user_recipients") {
+			diags.AddAttributeError(blockPath, "Missing Email Recipients", "At least one of recipients and user_recipients must be set.")
+		}
+		if isEmpty("body_template") && isEmpty("html_body_template") {
+			diags.AddAttributeError(blockPath, "Missing Email Body", "At least one of body_template and html_body_template must be set.")
+		}
+	case "pagerduty":
+		if isTrue("custom_incident") && isEmpty("key_prefix") {
+			diags.AddAttributeError(blockPath.AtName("key_prefix"), "Missing Key Prefix", "key_prefix is required when custom_incident is enabled.")
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationAttributeKind identifies how a typed notification attribute is
+// represented in Terraform and in the Graylog notification config.
+type notificationAttributeKind int
+
+const (
+	notificationString notificationAttributeKind = iota
+	notificationBool
+	notificationInt
+	notificationList
+	// notificationHeaders is a map sent as a 'name:value;...' string
+	notificationHeaders
+)
+
+// notificationAttribute describes an attribute of a typed notification block.
+type notificationAttribute struct {
+	name        string
+	key         string
+	kind        notificationAttributeKind
+	description string
+	required    bool
+	sensitive   bool
+	// defaultString and defaultInt are the Graylog defaults of optional attributes
+	defaultString string
+	defaultInt    int64
+	validators    []validator.String
+}
+
+// notificationTypeSpec describes a typed notification block and the Graylog
+// notification type it configures.
+type notificationTypeSpec struct {
+	block       string
+	configType  string
+	description string
+	attributes  []notificationAttribute
+	// encryptedKeys are the config keys Graylog stores as encrypted values,
+	// the only keys accepted in config_wo
+	encryptedKeys []string
+}
+
+var (
+	httpURLValidator  = stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http:// or https:// URL")
+	httpsURLValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https:// URL")
+)
+
+// notificationTypeSpecs lists the typed notification blocks.
+var notificationTypeSpecs = []notificationTypeSpec{
+	{
+		block:       "http",
+		configType:  "http-notification-v2",
+		description: "Sends the event to an HTTP endpoint (Custom HTTP Notification).",
+		attributes: []notificationAttribute{
+			{name: "url", key: "url", kind: notificationString, required: true, description: "The URL the event is sent to.", validators: []validator.String{httpURLValidator}},
+			{name: "method", key: "method", kind: notificationString, defaultString: "POST", description: "The HTTP method (GET, POST or PUT).", validators: []validator.String{stringvalidator.OneOf("GET", "POST", "PUT")}},
+			{name: "content_type", key: "content_type", kind: notificationString, defaultString: "JSON", description: "The content type of the request body (JSON, FORM_DATA or PLAIN_TEXT).", validators: []validator.String{stringvalidator.OneOf("JSON", "FORM_DATA", "PLAIN_TEXT")}},
+			{name: "headers", key: "headers", kind: notificationHeaders, description: "Additional HTTP headers sent with the request."},
+			{name: "body_template", key: "body_template", kind: notificationString, description: "The template rendering the request body."},
+			{name: "time_zone", key: "time_zone", kind: notificationString, defaultString: "UTC", description: "The time zone used to render timestamps."},
+			{name: "skip_tls_verification", key: "skip_tls_verification", kind: notificationBool, description: "Whether the TLS certificate of the endpoint is not verified."},
+			{name: "api_key_as_header", key: "api_key_as_header", kind: notificationBool, description: "Whether the API key is sent as header instead of a query parameter."},
+			{name: "api_key", key: "api_key", kind: notificationString, description: "The name of the API key header or query parameter."},
+		},
+		encryptedKeys: []string{"basic_auth", "api_secret"},
+	},
+	{
+		block:       "email",
+		configType:  "email-notification-v1",
+		description: "Sends the event by email.",
+		attributes: []notificationAttribute{
+			{name: "subject", key: "subject", kind: notificationString, required: true, description: "The template rendering the email subject."},
+			{name: "sender", key: "sender", kind: notificationString, description: "The sender address. The server default is used when empty."},
+			{name: "reply_to", key: "reply_to", kind: notificationString, description: "The reply-to address."},
+			{name: "body_template", key: "body_template", kind: notificationString, description: "The template rendering the plain text body."},
+			{name: "html_body_template", key: "html_body_template", kind: notificationString, description: "The template rendering the HTML body."},
+			{name: "recipients", key: "email_recipients", kind: notificationList, description: "The email addresses the notification is sent to."},
+			{name: "user_recipients", key: "user_recipients", kind: notificationList, description: "The Graylog users the notification is sent to."},
+			{name: "time_zone", key: "time_zone", kind: notificationString, defaultString: "UTC", description: "The time zone used to render timestamps."},
+			{name: "single_email", key: "single_email", kind: notificationBool, description: "Whether a single email is sent to all recipients."},
+		},
+	},
+	{
+		block:       "slack",
+		configType:  "slack-notification-v1",
+		description: "Sends the event to a Slack channel.",
+		attributes: []notificationAttribute{
+			{name: "webhook_url", key: "webhook_url", kind: notificationString, required: true, sensitive: true, description: "The Slack incoming webhook URL.", validators: []validator.String{httpsURLValidator}},
+			{name: "channel", key: "channel", kind: notificationString, required: true, description: "The channel or user the message is posted to, e.g. '#alerts'.", validators: []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[#@]`), "must start with '#' or '@'")}},
+			{name: "custom_message", key: "custom_message", kind: notificationString, description: "The template rendering the message."},
+			{name: "user_name", key: "user_name", kind: notificationString, description: "The user name the message is posted as."},
+			{name: "color", key: "color", kind: notificationString, defaultString: "#FF0000", description: "The color of the message attachment.", validators: []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a hex color such as '#FF0000'")}},
+			{name: "icon_url", key: "icon_url", kind: notificationString, description: "The URL of the message icon."},
+			{name: "icon_emoji", key: "icon_emoji", kind: notificationString, description: "The emoji used as message icon."},
+			{name: "backlog_size", key: "backlog_size", kind: notificationInt, description: "The number of backlog messages included in the message."},
+			{name: "notify_channel", key: "notify_channel", kind: notificationBool, description: "Whether @channel is notified."},
+			{name: "notify_here", key: "notify_here", kind: notificationBool, description: "Whether @here is notified."},
+			{name: "link_names", key: "link_names", kind: notificationBool, description: "Whether channel and user names in the message are linked."},
+			{name: "include_title", key: "include_title", kind: notificationBool, description: "Whether the event definition title is included."},
+			{name: "time_zone", key: "time_zone", kind: notificationString, defaultString: "UTC", description: "The time zone used to render timestamps."},
+		},
+	},
+	{
+		block:       "teams",
+		configType:  "teams-notification-v2",
+		description: "Sends the event to Microsoft Teams through a workflow webhook.",
+		attributes: []notificationAttribute{
+			{name: "webhook_url", key: "webhook_url", kind: notificationString, required: true, sensitive: true, description: "The Teams workflow webhook URL.", validators: []validator.String{httpsURLValidator}},
+			{name: "adaptive_card", key: "adaptive_card", kind: notificationString, required: true, description: "The template rendering the adaptive card JSON."},
+			{name: "backlog_size", key: "backlog_size", kind: notificationInt, description: "The number of backlog messages included in the card."},
+			{name: "time_zone", key: "time_zone", kind: notificationString, defaultString: "UTC", description: "The time zone used to render timestamps."},
+		},
+	},
+	{
+		block:       "pagerduty",
+		configType:  "pagerduty-notification-v2",
+		description: "Triggers a PagerDuty incident.",
+		attributes: []notificationAttribute{
+			{name: "routing_key", key: "routing_key", kind: notificationString, required: true, sensitive: true, description: "The 32 character integration key of the PagerDuty service.", validators: []validator.String{stringvalidator.LengthBetween(32, 32)}},
+			{name: "custom_incident", key: "custom_incident", kind: notificationBool, description: "Whether the incident key is built from key_prefix and the event."},
+			{name: "key_prefix", key: "key_prefix", kind: notificationString, description: "The prefix of custom incident keys."},
+			{name: "client_name", key: "client_name", kind: notificationString, description: "The client name shown in PagerDuty."},
+			{name: "client_url", key: "client_url", kind: notificationString, description: "The client URL shown in PagerDuty.", validators: []validator.String{stringvalidator.Any(httpURLValidator, stringvalidator.LengthAtMost(0))}},
+		},
+	},
+	{
+		block:       "script",
+		configType:  "script-notification-v1",
+		description: "Runs a script on the Graylog server.",
+		attributes: []notificationAttribute{
+			{name: "script_path", key: "script_path", kind: notificationString, required: true, description: "The absolute path of the script.", validators: []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must be an absolute path")}},
+			{name: "script_args", key: "script_args", kind: notificationString, description: "The arguments passed to the script."},
+			{name: "script_timeout", key: "script_timeout", kind: notificationInt, defaultInt: 10000, description: "The script timeout in milliseconds."},
+			{name: "script_send_stdin", key: "script_send_stdin", kind: notificationBool, description: "Whether the event is sent to the script as JSON on standard input."},
+		},
+	},
+}
+
+// notificationTypeSpecByBlock returns the spec of a typed notification block.
+func notificationTypeSpecByBlock(block string) *notificationTypeSpec {
+	for i := range notificationTypeSpecs {
+		if notificationTypeSpecs[i].block == block {
+			return &notificationTypeSpecs[i]
+		}
+	}
+	return nil
+}
+
+// notificationTypeSpecByConfigType returns the spec of a Graylog notification type.
+func notificationTypeSpecByConfigType(configType string) *notificationTypeSpec {
+	for i := range notificationTypeSpecs {
+		if notificationTypeSpecs[i].configType == configType {
+			return &notificationTypeSpecs[i]
+		}
+	}
+	return nil
+}
+
+// attrType returns the Terraform type of the attribute.
+func (a notificationAttribute) attrType() attr.Type {
+	switch a.kind {
+	case notificationBool:
+		return types.BoolType
+	case notificationInt:
+		return types.Int64Type
+	case notificationList:
+		return types.ListType{ElemType: types.StringType}
+	case notificationHeaders:
+		return types.MapType{ElemType: types.StringType}
+	}
+	return types.StringType
+}
+
+// attrTypes returns the object attribute types of the block.
+func (s notificationTypeSpec) attrTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(s.attributes))
+	for _, a := range s.attributes {
+		attrTypes[a.name] = a.attrType()
+	}
+	return attrTypes
+}
+
+// attribute returns the attribute of the block with the given name.
+func (s notificationTypeSpec) attribute(name string) notificationAttribute {
+	for _, a := range s.attributes {
+		if a.name == name {
+			return a
+		}
+	}
+	return notificationAttribute{}
+}
+
+// schemaBlock returns the schema of the block.
+func (s notificationTypeSpec) schemaBlock() schema.SingleNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(s.attributes))
+	for _, a := range s.attributes {
+		optional := !a.required
+		switch a.kind {
+		case notificationBool:
+			attribute := schema.BoolAttribute{Description: a.description, Required: a.required, Optional: optional, Computed: optional, Sensitive: a.sensitive}
+			if optional {
+				attribute.Default = booldefault.StaticBool(false)
+			}
+			attributes[a.name] = attribute
+		case notificationInt:
+			attribute := schema.Int64Attribute{Description: a.description, Required: a.required, Optional: optional, Computed: optional, Sensitive: a.sensitive}
+			if optional {
+				attribute.Default = int64default.StaticInt64(a.defaultInt)
+			}
+			attributes[a.name] = attribute
+		case notificationList:
+			attribute := schema.ListAttribute{Description: a.description, Required: a.required, Optional: optional, Computed: optional, Sensitive: a.sensitive, ElementType: types.StringType}
+			if optional {
+				attribute.Default = listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{}))
+			}
+			attributes[a.name] = attribute
+		case notificationHeaders:
+			attribute := schema.MapAttribute{Description: a.description, Required: a.required, Optional: optional, Computed: optional, Sensitive: a.sensitive, ElementType: types.StringType}
+			if optional {
+				attribute.Default = mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{}))
+			}
+			attributes[a.name] = attribute
+		default:
+			attribute := schema.StringAttribute{Description: a.description, Required: a.required, Optional: optional, Computed: optional, Sensitive: a.sensitive, Validators: a.validators}
+			if optional {
+				attribute.Default = stringdefault.StaticString(a.defaultString)
+			}
+			attributes[a.name] = attribute
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: s.description + " Sets notification_type to '" + s.configType + "'.",
+		Attributes:  attributes,
+	}
+}
+
+// config converts the block into Graylog notification config entries.
+func (s notificationTypeSpec) config(ctx context.Context, block types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := map[string]interface{}{
+		"type": s.configType,
+	}
+	values := block.Attributes()
+	for _, a := range s.attributes {
+		switch value := values[a.name].(type) {
+		case types.String:
+			config[a.key] = value.ValueString()
+		case types.Bool:
+			config[a.key] = value.ValueBool()
+		case types.Int64:
+			config[a.key] = value.ValueInt64()
+		case types.List:
+			items := []string{}
+			if !value.IsNull() && !value.IsUnknown() {
+				diags.Append(value.ElementsAs(ctx, &items, false)...)
+			}
+			config[a.key] = items
+		case types.Map:
+			headers := map[string]string{}
+			if !value.IsNull() && !value.IsUnknown() {
+				diags.Append(value.ElementsAs(ctx, &headers, false)...)
+			}
+			config[a.key] = formatNotificationHeaders(headers)
+		}
+	}
+
+	return config, diags
+}
+
+// object converts a Graylog notification config into the block.
+func (s notificationTypeSpec) object(ctx context.Context, config map[string]interface{}) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make(map[string]attr.Value, len(s.attributes))
+	for _, a := range s.attributes {
+		raw := config[a.key]
+		switch a.kind {
+		case notificationBool:
+			values[a.name] = types.BoolValue(raw == true)
+		case notificationInt:
+			values[a.name] = types.Int64Value(int64FromConfig(raw))
+		case notificationList:
+			value, d := types.ListValueFrom(ctx, types.StringType, stringsFromConfig(raw))
+			diags.Append(d...)
+			values[a.name] = value
+		case notificationHeaders:
+			value, d := types.MapValueFrom(ctx, types.StringType, parseNotificationHeaders(stringFromConfig(raw)))
+			diags.Append(d...)
+			values[a.name] = value
+		default:
+			values[a.name] = types.StringValue(stringFromConfig(raw))
+		}
+	}
+
+	object, d := types.ObjectValue(s.attrTypes(), values)
+	diags.Append(d...)
+	return object, diags
+}
+
+// formatNotificationHeaders renders headers in the 'name:value;...' format
+// Graylog expects, sorted by name.
+func formatNotificationHeaders(headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	entries := make([]string, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, fmt.Sprintf("%s:%s", name, headers[name]))
+	}
+	return strings.Join(entries, ";")
+}
+
+// parseNotificationHeaders parses headers in the 'name:value;...' format.
+func parseNotificationHeaders(value string) map[string]string {
+	headers := make(map[string]string)
+	for _, entry := range strings.Split(value, ";") {
+		name, headerValue, found := strings.Cut(entry, ":")
+		if !found || strings.TrimSpace(name) == "" {
+			continue
+		}
+		headers[strings.TrimSpace(name)] = strings.TrimSpace(headerValue)
+	}
+	return headers
+}
@@ -0,0 +1,529 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &extractorResource{}
+	_ resource.ResourceWithConfigure      = &extractorResource{}
+	_ resource.ResourceWithImportState    = &extractorResource{}
+	_ resource.ResourceWithValidateConfig = &extractorResource{}
+)
+
+// NewExtractorResource is a helper function to simplify the provider implementation.
+func NewExtractorResource() resource.Resource {
+	return &extractorResource{}
+}
+
+// extractorResource is the resource implementation.
+type extractorResource struct {
+	client *client.Client
+}
+
+// extractorResourceModel maps the resource schema data.
+type extractorResourceModel struct {
+	ID             types.String              `tfsdk:"id"`
+	ExtractorID    types.String              `tfsdk:"extractor_id"`
+	InputID        types.String              `tfsdk:"input_id"`
+	Title          types.String              `tfsdk:"title"`
+	Type           types.String              `tfsdk:"type"`
+	SourceField    types.String              `tfsdk:"source_field"`
+	TargetField    types.String              `tfsdk:"target_field"`
+	CutOrCopy      types.String              `tfsdk:"cut_or_copy"`
+	Config         types.Map                 `tfsdk:"config"`
+	ConditionType  types.String              `tfsdk:"condition_type"`
+	ConditionValue types.String              `tfsdk:"condition_value"`
+	Order          types.Int64               `tfsdk:"order"`
+	Converters     []extractorConverterModel `tfsdk:"converter"`
+	Timeouts       timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// extractorConverterModel maps a converter block.
+type extractorConverterModel struct {
+	Type   types.String `tfsdk:"type"`
+	Config types.Map    `tfsdk:"config"`
+}
+
+// Metadata returns the resource type name.
+func (r *extractorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extractor"
+}
+
+// Schema defines the schema for the resource.
+func (r *extractorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an extractor of a Graylog input, extracting data from a message field into other fields.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the extractor in the form '<input_id>/<extractor_id>'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extractor_id": schema.StringAttribute{
+				Description: "The unique identifier of the extractor.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"input_id": schema.StringAttribute{
+				Description: "The ID of the input the extractor belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the extractor.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The extractor type (copy_input, grok, json, lookup_table, regex, split_and_index or substring).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(extractorTypeNames(extractorConfigKeys)...),
+				},
+			},
+			"source_field": schema.StringAttribute{
+				Description: "The message field the data is extracted from.",
+				Required:    true,
+			},
+			"target_field": schema.StringAttribute{
+				Description: "The message field the extracted data is written to. Not used by the grok and json types.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"cut_or_copy": schema.StringAttribute{
+				Description: "Whether the extracted data is copied from or cut out of the source field ('copy' or 'cut').",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("copy"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("copy", "cut"),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The settings of the extractor type, e.g. 'regex_value' for regex, 'grok_pattern' for grok, 'split_by' and 'index' for split_and_index, 'begin_index' and 'end_index' for substring or 'lookup_table_name' for lookup_table.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"condition_type": schema.StringAttribute{
+				Description: "When the extractor runs: always ('none'), if the source field contains condition_value ('string') or matches the regular expression condition_value ('regex').",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "string", "regex"),
+				},
+			},
+			"condition_value": schema.StringAttribute{
+				Description: "The string or regular expression of the condition.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"order": schema.Int64Attribute{
+				Description: "The position of the extractor among the extractors of the input. Extractors run in ascending order.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"converter": schema.ListNestedBlock{
+				Description: "A converter applied to the extracted value.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The converter type (csv, date, flexdate, hash, ip_anonymizer, lookup_table, lowercase, numeric, split_and_count, syslog_pri_facility, syslog_pri_level, tokenizer or uppercase).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(extractorTypeNames(extractorConverterConfigKeys)...),
+							},
+						},
+						"config": schema.MapAttribute{
+							Description: "The settings of the converter type, e.g. 'date_format' and 'time_zone' for date.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *extractorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the extractor and converter settings against their types.
+func (r *extractorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config extractorResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsUnknown() && !config.Type.IsNull() && !config.Config.IsUnknown() {
+		extractorType := config.Type.ValueString()
+		if keys, ok := extractorConfigKeys[extractorType]; ok {
+			settings, diags := stringMapValue(ctx, config.Config)
+			resp.Diagnostics.Append(diags...)
+			validateExtractorConfig(path.Root("config"), extractorType, keys, settings, &resp.Diagnostics)
+		}
+
+		if !extractorTargetlessTypes[extractorType] && config.TargetField.ValueString() == "" && !config.TargetField.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_field"),
+				"Missing Target Field",
+				fmt.Sprintf("Extractors of type %q require a target_field.", extractorType),
+			)
+		}
+	}
+
+	if config.ConditionType.ValueString() != "" && config.ConditionType.ValueString() != "none" &&
+		!config.ConditionValue.IsUnknown() && config.ConditionValue.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("condition_value"),
+			"Missing Condition Value",
+			"A condition_value is required when condition_type is '"+config.ConditionType.ValueString()+"'.",
+		)
+	}
+
+	converterTypes := make(map[string]bool)
+	for i, converter := range config.Converters {
+		if converter.Type.IsUnknown() || converter.Type.IsNull() || converter.Config.IsUnknown() {
+			continue
+		}
+		converterType := converter.Type.ValueString()
+		converterPath := path.Root("converter").AtListIndex(i)
+		if converterTypes[converterType] {
+			resp.Diagnostics.AddAttributeError(
+				converterPath.AtName("type"),
+				"Duplicate Converter",
+				fmt.Sprintf("The converter %q is defined more than once.", converterType),
+			)
+		}
+		converterTypes[converterType] = true
+
+		if keys, ok := extractorConverterConfigKeys[converterType]; ok {
+			settings, diags := stringMapValue(ctx, converter.Config)
+			resp.Diagnostics.Append(diags...)
+			validateExtractorConfig(converterPath.AtName("config"), converterType, keys, settings, &resp.Diagnostics)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *extractorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan extractorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	extractorReq, diags := extractorRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the extractor
+	extractor, err := r.client.CreateExtractor(ctx, plan.InputID.ValueString(), extractorReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Extractor",
+			"Could not create extractor, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ExtractorID = types.StringValue(extractor.ID)
+	plan.ID = types.StringValue(plan.InputID.ValueString() + "/" + extractor.ID)
+	plan.Order = types.Int64Value(extractor.Order)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *extractorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state extractorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get extractor from API
+	extractor, err := r.client.GetExtractor(ctx, state.InputID.ValueString(), state.ExtractorID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Extractor",
+			"Could not read extractor ID "+state.ExtractorID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	imported := state.Title.IsNull()
+	resp.Diagnostics.Append(mapExtractorToModel(ctx, extractor, &state, imported)...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *extractorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan extractorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	extractorReq, diags := extractorRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the extractor
+	extractor, err := r.client.UpdateExtractor(ctx, plan.InputID.ValueString(), plan.ExtractorID.ValueString(), extractorReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Extractor",
+			"Could not update extractor, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.Order = types.Int64Value(extractor.Order)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *extractorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state extractorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete extractor via API
+	err := r.client.DeleteExtractor(ctx, state.InputID.ValueString(), state.ExtractorID.ValueString())
+	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Extractor",
+			"Could not delete extractor, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from a '<input_id>/<extractor_id>' identifier.
+func (r *extractorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <input_id>/<extractor_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("input_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("extractor_id"), parts[1])...)
+}
+
+// extractorRequest builds the API request from the model.
+func extractorRequest(ctx context.Context, plan *extractorResourceModel) (*client.ExtractorRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings, d := stringMapValue(ctx, plan.Config)
+	diags.Append(d...)
+
+	converterConfigs := make([]map[string]string, len(plan.Converters))
+	for i, converter := range plan.Converters {
+		converterConfigs[i], d = stringMapValue(ctx, converter.Config)
+		diags.Append(d...)
+	}
+
+	return &client.ExtractorRequest{
+		Title:           plan.Title.ValueString(),
+		CutOrCopy:       plan.CutOrCopy.ValueString(),
+		SourceField:     plan.SourceField.ValueString(),
+		TargetField:     plan.TargetField.ValueString(),
+		ExtractorType:   plan.Type.ValueString(),
+		ExtractorConfig: extractorConfig(extractorConfigKeys[plan.Type.ValueString()], settings),
+		Converters:      extractorConverters(plan.Converters, converterConfigs),
+		ConditionType:   plan.ConditionType.ValueString(),
+		ConditionValue:  plan.ConditionValue.ValueString(),
+		Order:           plan.Order.ValueInt64(),
+	}, diags
+}
+
+// mapExtractorToModel copies the API representation of an extractor into the
+// model, keeping only the config keys tracked in state unless imported.
+func mapExtractorToModel(ctx context.Context, extractor *client.Extractor, model *extractorResourceModel, imported bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ExtractorID = types.StringValue(extractor.ID)
+	model.ID = types.StringValue(model.InputID.ValueString() + "/" + extractor.ID)
+	model.Title = types.StringValue(extractor.Title)
+	model.Type = types.StringValue(extractor.Type)
+	model.SourceField = types.StringValue(extractor.SourceField)
+	model.TargetField = types.StringValue(extractor.TargetField)
+	model.CutOrCopy = types.StringValue(extractor.CursorStrategy)
+	model.ConditionType = types.StringValue(extractor.ConditionType)
+	model.ConditionValue = types.StringValue(extractor.ConditionValue)
+	model.Order = types.Int64Value(extractor.Order)
+
+	if !model.Config.IsNull() || imported {
+		tracked, d := stringMapValue(ctx, model.Config)
+		diags.Append(d...)
+		if imported {
+			tracked = nil
+		}
+		settings := extractorConfigStrings(extractor.ExtractorConfig, tracked)
+		if len(settings) > 0 || !model.Config.IsNull() {
+			model.Config, d = types.MapValueFrom(ctx, types.StringType, settings)
+			diags.Append(d...)
+		}
+	}
+
+	previous := model.Converters
+	model.Converters = nil
+	for _, converter := range extractor.Converters {
+		converterModel := extractorConverterModel{
+			Type:   types.StringValue(converter.Type),
+			Config: types.MapNull(types.StringType),
+		}
+
+		var tracked map[string]string
+		trackedNull := true
+		for _, prev := range previous {
+			if prev.Type.ValueString() == converter.Type {
+				var d diag.Diagnostics
+				tracked, d = stringMapValue(ctx, prev.Config)
+				diags.Append(d...)
+				trackedNull = prev.Config.IsNull()
+			}
+		}
+		if imported {
+			tracked = nil
+		}
+
+		settings := extractorConfigStrings(converter.Config, tracked)
+		if !trackedNull || (imported && len(settings) > 0) {
+			var d diag.Diagnostics
+			converterModel.Config, d = types.MapValueFrom(ctx, types.StringType, settings)
+			diags.Append(d...)
+		}
+
+		model.Converters = append(model.Converters, converterModel)
+	}
+
+	return diags
+}
+
+// stringMapValue converts a map value into a Go map, empty for null values.
+func stringMapValue(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	result := make(map[string]string)
+	if value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
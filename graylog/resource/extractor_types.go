@@ -0,0 +1,223 @@
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// extractorConfigKind describes how a config value is sent to Graylog.
+type extractorConfigKind int
+
+const (
+	extractorConfigString extractorConfigKind = iota
+	extractorConfigBool
+	extractorConfigInt
+)
+
+// extractorConfigKey describes a key of an extractor or converter config.
+type extractorConfigKey struct {
+	kind     extractorConfigKind
+	required bool
+}
+
+// extractorConfigKeys lists the config keys of each extractor type.
+var extractorConfigKeys = map[string]map[string]extractorConfigKey{
+	"regex": {
+		"regex_value": {kind: extractorConfigString, required: true},
+	},
+	"grok": {
+		"grok_pattern":        {kind: extractorConfigString, required: true},
+		"named_captures_only": {kind: extractorConfigBool},
+	},
+	"json": {
+		"flatten":                    {kind: extractorConfigBool},
+		"list_separator":             {kind: extractorConfigString},
+		"key_separator":              {kind: extractorConfigString},
+		"kv_separator":               {kind: extractorConfigString},
+		"key_prefix":                 {kind: extractorConfigString},
+		"replace_key_whitespace":     {kind: extractorConfigBool},
+		"key_whitespace_replacement": {kind: extractorConfigString},
+	},
+	"split_and_index": {
+		"split_by": {kind: extractorConfigString, required: true},
+		"index":    {kind: extractorConfigInt, required: true},
+	},
+	"substring": {
+		"begin_index": {kind: extractorConfigInt, required: true},
+		"end_index":   {kind: extractorConfigInt, required: true},
+	},
+	"lookup_table": {
+		"lookup_table_name": {kind: extractorConfigString, required: true},
+	},
+	"copy_input": {},
+}
+
+// extractorTargetlessTypes lists the extractor types that derive the target
+// fields from the extracted data.
+var extractorTargetlessTypes = map[string]bool{
+	"grok": true,
+	"json": true,
+}
+
+// extractorConverterConfigKeys lists the config keys of each converter type.
+var extractorConverterConfigKeys = map[string]map[string]extractorConfigKey{
+	"numeric":             {},
+	"hash":                {},
+	"lowercase":           {},
+	"uppercase":           {},
+	"syslog_pri_level":    {},
+	"syslog_pri_facility": {},
+	"ip_anonymizer":       {},
+	"tokenizer":           {},
+	"date": {
+		"date_format": {kind: extractorConfigString, required: true},
+		"time_zone":   {kind: extractorConfigString},
+		"locale":      {kind: extractorConfigString},
+	},
+	"flexdate": {
+		"time_zone": {kind: extractorConfigString},
+	},
+	"split_and_count": {
+		"split_by": {kind: extractorConfigString, required: true},
+	},
+	"csv": {
+		"column_header":           {kind: extractorConfigString, required: true},
+		"separator":               {kind: extractorConfigString},
+		"quote_char":              {kind: extractorConfigString},
+		"escape_char":             {kind: extractorConfigString},
+		"strict_quotes":           {kind: extractorConfigBool},
+		"trim_leading_whitespace": {kind: extractorConfigBool},
+	},
+	"lookup_table": {
+		"lookup_table_name": {kind: extractorConfigString, required: true},
+	},
+}
+
+// extractorTypeNames returns the sorted names of a config key table.
+func extractorTypeNames(table map[string]map[string]extractorConfigKey) []string {
+	names := make([]string, 0, len(table))
+	for name := range table {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateExtractorConfig checks a config map against the keys of its type:
+// unknown keys, missing required keys and values not matching their kind.
+func validateExtractorConfig(configPath path.Path, typeName string, keys map[string]extractorConfigKey, config map[string]string, diags *diag.Diagnostics) {
+	for key, value := range config {
+		spec, ok := keys[key]
+		if !ok {
+			diags.AddAttributeError(
+				configPath.AtMapKey(key),
+				"Unknown Config Key",
+				fmt.Sprintf("The key %q is not supported by type %q.", key, typeName),
+			)
+			continue
+		}
+		if _, err := extractorConfigValue(spec.kind, value); err != nil {
+			diags.AddAttributeError(
+				configPath.AtMapKey(key),
+				"Invalid Config Value",
+				fmt.Sprintf("The key %q of type %q %s.", key, typeName, err.Error()),
+			)
+		}
+	}
+
+	for _, key := range sortedConfigKeys(keys) {
+		if _, ok := config[key]; !ok && keys[key].required {
+			diags.AddAttributeError(
+				configPath,
+				"Missing Config Key",
+				fmt.Sprintf("Type %q requires the key %q.", typeName, key),
+			)
+		}
+	}
+}
+
+// extractorConfig converts a config map into the typed values sent to Graylog.
+func extractorConfig(keys map[string]extractorConfigKey, config map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		converted, err := extractorConfigValue(keys[key].kind, value)
+		if err != nil {
+			converted = value
+		}
+		result[key] = converted
+	}
+	return result
+}
+
+// extractorConfigValue converts a config value to its kind.
+func extractorConfigValue(kind extractorConfigKind, value string) (interface{}, error) {
+	switch kind {
+	case extractorConfigBool:
+		converted, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be true or false, got %q", value)
+		}
+		return converted, nil
+	case extractorConfigInt:
+		converted, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be an integer, got %q", value)
+		}
+		return converted, nil
+	}
+	return value, nil
+}
+
+// extractorConfigStrings converts a config returned by Graylog into a config
+// map, keeping only the tracked keys unless tracked is nil.
+func extractorConfigStrings(config map[string]interface{}, tracked map[string]string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range config {
+		if tracked != nil {
+			if _, ok := tracked[key]; !ok {
+				continue
+			}
+		}
+		if value == nil {
+			continue
+		}
+		switch v := value.(type) {
+		case float64:
+			result[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			result[key] = v
+		default:
+			result[key] = fmt.Sprintf("%v", v)
+		}
+	}
+	return result
+}
+
+// extractorConverters converts the converter models into the Graylog
+// converters, keeping their order.
+func extractorConverters(converters []extractorConverterModel, configs []map[string]string) client.ConverterMap {
+	result := make(client.ConverterMap, 0, len(converters))
+	for i, converter := range converters {
+		converterType := converter.Type.ValueString()
+		result = append(result, client.ExtractorConverter{
+			Type:   converterType,
+			Config: extractorConfig(extractorConverterConfigKeys[converterType], configs[i]),
+		})
+	}
+	return result
+}
+
+// sortedConfigKeys returns the sorted keys of a config key table.
+func sortedConfigKeys(keys map[string]extractorConfigKey) []string {
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
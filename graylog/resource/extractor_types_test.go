@@ -0,0 +1,197 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// TestValidateExtractorConfig tests the validation of extractor and converter
+// configs against the keys of their type
+func TestValidateExtractorConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		typeName      string
+		keys          map[string]extractorConfigKey
+		config        map[string]string
+		expectSummary []string
+	}{
+		{
+			name:     "Valid config",
+			typeName: "split_and_index",
+			keys:     extractorConfigKeys["split_and_index"],
+			config:   map[string]string{"split_by": ",", "index": "2"},
+		},
+		{
+			name:     "Valid optional keys",
+			typeName: "grok",
+			keys:     extractorConfigKeys["grok"],
+			config:   map[string]string{"grok_pattern": "%{IP:client}", "named_captures_only": "true"},
+		},
+		{
+			name:     "Type without keys",
+			typeName: "copy_input",
+			keys:     extractorConfigKeys["copy_input"],
+			config:   map[string]string{},
+		},
+		{
+			name:          "Unknown key",
+			typeName:      "regex",
+			keys:          extractorConfigKeys["regex"],
+			config:        map[string]string{"regex_value": "^(.*)$", "grok_pattern": "%{IP}"},
+			expectSummary: []string{"Unknown Config Key"},
+		},
+		{
+			name:          "Missing required key",
+			typeName:      "substring",
+			keys:          extractorConfigKeys["substring"],
+			config:        map[string]string{"begin_index": "0"},
+			expectSummary: []string{"Missing Config Key"},
+		},
+		{
+			name:          "Invalid integer",
+			typeName:      "substring",
+			keys:          extractorConfigKeys["substring"],
+			config:        map[string]string{"begin_index": "0", "end_index": "ten"},
+			expectSummary: []string{"Invalid Config Value"},
+		},
+		{
+			name:          "Invalid boolean",
+			typeName:      "json",
+			keys:          extractorConfigKeys["json"],
+			config:        map[string]string{"flatten": "yes"},
+			expectSummary: []string{"Invalid Config Value"},
+		},
+		{
+			name:          "Converter missing required key",
+			typeName:      "date",
+			keys:          extractorConverterConfigKeys["date"],
+			config:        map[string]string{"time_zone": "UTC"},
+			expectSummary: []string{"Missing Config Key"},
+		},
+		{
+			name:          "Converter without keys",
+			typeName:      "numeric",
+			keys:          extractorConverterConfigKeys["numeric"],
+			config:        map[string]string{"precision": "2"},
+			expectSummary: []string{"Unknown Config Key"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateExtractorConfig(path.Root("config"), tt.typeName, tt.keys, tt.config, &diags)
+
+			summaries := []string{}
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+
+			if len(summaries) != len(tt.expectSummary) {
+				t.Fatalf("Expected errors %v, got %v", tt.expectSummary, summaries)
+			}
+			for i, summary := range summaries {
+				if summary != tt.expectSummary[i] {
+					t.Errorf("Expected error %q, got %q", tt.expectSummary[i], summary)
+				}
+			}
+		})
+	}
+}
+
+// TestExtractorConfig tests the conversion of config values to their kind
+func TestExtractorConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     map[string]extractorConfigKey
+		config   map[string]string
+		expected map[string]interface{}
+	}{
+		{
+			name:     "Strings and integers",
+			keys:     extractorConfigKeys["split_and_index"],
+			config:   map[string]string{"split_by": ",", "index": "2"},
+			expected: map[string]interface{}{"split_by": ",", "index": int64(2)},
+		},
+		{
+			name:     "Booleans",
+			keys:     extractorConfigKeys["json"],
+			config:   map[string]string{"flatten": "true", "key_separator": "_"},
+			expected: map[string]interface{}{"flatten": true, "key_separator": "_"},
+		},
+		{
+			name:     "Invalid values are kept as strings",
+			keys:     extractorConfigKeys["substring"],
+			config:   map[string]string{"begin_index": "first"},
+			expected: map[string]interface{}{"begin_index": "first"},
+		},
+		{
+			name:     "Unknown keys are kept as strings",
+			keys:     extractorConfigKeys["regex"],
+			config:   map[string]string{"custom": "1"},
+			expected: map[string]interface{}{"custom": "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := extractorConfig(tt.keys, tt.config)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+// TestExtractorConfigStrings tests the conversion of configs returned by
+// Graylog into config maps
+func TestExtractorConfigStrings(t *testing.T) {
+	apiConfig := map[string]interface{}{
+		"split_by":      ",",
+		"index":         float64(2),
+		"precision":     float64(0.5),
+		"flatten":       true,
+		"key_separator": nil,
+	}
+
+	tests := []struct {
+		name     string
+		tracked  map[string]string
+		expected map[string]string
+	}{
+		{
+			name:    "All keys",
+			tracked: nil,
+			expected: map[string]string{
+				"split_by":  ",",
+				"index":     "2",
+				"precision": "0.5",
+				"flatten":   "true",
+			},
+		},
+		{
+			name:     "Tracked keys",
+			tracked:  map[string]string{"index": "3", "key_separator": "_"},
+			expected: map[string]string{"index": "2"},
+		},
+		{
+			name:     "No tracked keys",
+			tracked:  map[string]string{},
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := extractorConfigStrings(apiConfig, tt.tracked)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
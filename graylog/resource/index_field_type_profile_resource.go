@@ -0,0 +1,332 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &indexFieldTypeProfileResource{}
+	_ resource.ResourceWithConfigure      = &indexFieldTypeProfileResource{}
+	_ resource.ResourceWithImportState    = &indexFieldTypeProfileResource{}
+	_ resource.ResourceWithValidateConfig = &indexFieldTypeProfileResource{}
+)
+
+// NewIndexFieldTypeProfileResource is a helper function to simplify the provider implementation.
+func NewIndexFieldTypeProfileResource() resource.Resource {
+	return &indexFieldTypeProfileResource{}
+}
+
+// indexFieldTypeProfileResource is the resource implementation.
+type indexFieldTypeProfileResource struct {
+	client *client.Client
+}
+
+// indexFieldTypeProfileResourceModel maps the resource schema data.
+type indexFieldTypeProfileResourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	Name          types.String              `tfsdk:"name"`
+	Description   types.String              `tfsdk:"description"`
+	FieldMappings []customFieldMappingModel `tfsdk:"custom_field_mapping"`
+	Timeouts      timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// customFieldMappingModel maps a custom_field_mapping block.
+type customFieldMappingModel struct {
+	Field types.String `tfsdk:"field"`
+	Type  types.String `tfsdk:"type"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexFieldTypeProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_field_type_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexFieldTypeProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Graylog index field type profile, a reusable set of field types that index sets can use. Requires Graylog 6 or newer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the profile.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the profile.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the profile.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"custom_field_mapping": schema.SetNestedBlock{
+				Description: "The type of a field in the indices of the index sets using the profile.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "The name of the field.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the field (binary, boolean, byte, date, double, float, geo-point, int, ip, long, short, string or string_fts).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.IndexFieldTypes...),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexFieldTypeProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that every field is mapped only once.
+func (r *indexFieldTypeProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config indexFieldTypeProfileResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := make(map[string]bool)
+	for _, mapping := range config.FieldMappings {
+		if mapping.Field.IsUnknown() || mapping.Field.IsNull() {
+			continue
+		}
+		if fields[mapping.Field.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_field_mapping"),
+				"Duplicate Field Mapping",
+				"The field '"+mapping.Field.ValueString()+"' is mapped more than once.",
+			)
+		}
+		fields[mapping.Field.ValueString()] = true
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexFieldTypeProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan indexFieldTypeProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the profile
+	profile, err := r.client.CreateIndexFieldTypeProfile(ctx, indexFieldTypeProfileRequest(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Index Field Type Profile",
+			"Could not create index field type profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	mapIndexFieldTypeProfileToModel(profile, &plan)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexFieldTypeProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state indexFieldTypeProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get profile from API
+	profile, err := r.client.GetIndexFieldTypeProfile(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Index Field Type Profile",
+			"Could not read index field type profile ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	mapIndexFieldTypeProfileToModel(profile, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexFieldTypeProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan indexFieldTypeProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update the profile
+	profile, err := r.client.UpdateIndexFieldTypeProfile(ctx, plan.ID.ValueString(), indexFieldTypeProfileRequest(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Index Field Type Profile",
+			"Could not update index field type profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	mapIndexFieldTypeProfileToModel(profile, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexFieldTypeProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexFieldTypeProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete profile via API
+	err := r.client.DeleteIndexFieldTypeProfile(ctx, state.ID.ValueString())
+	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Index Field Type Profile",
+			"Could not delete index field type profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *indexFieldTypeProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// indexFieldTypeProfileRequest builds the API request from the model.
+func indexFieldTypeProfileRequest(plan *indexFieldTypeProfileResourceModel) *client.IndexFieldTypeProfileRequest {
+	mappings := make([]client.CustomFieldMapping, 0, len(plan.FieldMappings))
+	for _, mapping := range plan.FieldMappings {
+		mappings = append(mappings, client.CustomFieldMapping{
+			Field: mapping.Field.ValueString(),
+			Type:  mapping.Type.ValueString(),
+		})
+	}
+
+	return &client.IndexFieldTypeProfileRequest{
+		Name:                plan.Name.ValueString(),
+		Description:         plan.Description.ValueString(),
+		CustomFieldMappings: mappings,
+	}
+}
+
+// mapIndexFieldTypeProfileToModel copies the API representation of a profile into the model.
+func mapIndexFieldTypeProfileToModel(profile *client.IndexFieldTypeProfile, model *indexFieldTypeProfileResourceModel) {
+	model.ID = types.StringValue(profile.ID)
+	model.Name = types.StringValue(profile.Name)
+	model.Description = types.StringValue(profile.Description)
+
+	model.FieldMappings = nil
+	for _, mapping := range profile.CustomFieldMappings {
+		model.FieldMappings = append(model.FieldMappings, customFieldMappingModel{
+			Field: types.StringValue(mapping.Field),
+			Type:  types.StringValue(mapping.Type),
+		})
+	}
+	sort.Slice(model.FieldMappings, func(i, j int) bool {
+		return model.FieldMappings[i].Field.ValueString() < model.FieldMappings[j].Field.ValueString()
+	})
+}
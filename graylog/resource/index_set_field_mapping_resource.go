@@ -0,0 +1,306 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexSetFieldMappingResource{}
+	_ resource.ResourceWithConfigure   = &indexSetFieldMappingResource{}
+	_ resource.ResourceWithImportState = &indexSetFieldMappingResource{}
+)
+
+// NewIndexSetFieldMappingResource is a helper function to simplify the provider implementation.
+func NewIndexSetFieldMappingResource() resource.Resource {
+	return &indexSetFieldMappingResource{}
+}
+
+// indexSetFieldMappingResource is the resource implementation.
+type indexSetFieldMappingResource struct {
+	client *client.Client
+}
+
+// indexSetFieldMappingResourceModel maps the resource schema data.
+type indexSetFieldMappingResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	IndexSetID        types.String   `tfsdk:"index_set_id"`
+	Field             types.String   `tfsdk:"field"`
+	Type              types.String   `tfsdk:"type"`
+	RotateImmediately types.Bool     `tfsdk:"rotate_immediately"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexSetFieldMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_set_field_mapping"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexSetFieldMappingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the custom type of a field in the indices of a Graylog index set. The type applies to indices created after the next rotation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the field mapping in the form '<index_set_id>/<field>'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_set_id": schema.StringAttribute{
+				Description: "The ID of the index set.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field": schema.StringAttribute{
+				Description: "The name of the field.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the field (binary, boolean, byte, date, double, float, geo-point, int, ip, long, short, string or string_fts).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.IndexFieldTypes...),
+				},
+			},
+			"rotate_immediately": schema.BoolAttribute{
+				Description: "Whether the active index is rotated when the mapping changes, so the type applies right away.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexSetFieldMappingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexSetFieldMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan indexSetFieldMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Set the field type
+	err := r.client.SetFieldType(ctx, fieldTypeChangeRequest(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Index Set Field Mapping",
+			"Could not set field type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(plan.IndexSetID.ValueString() + "/" + plan.Field.ValueString())
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexSetFieldMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state indexSetFieldMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get index set from API
+	indexSet, err := r.client.GetIndexSet(ctx, state.IndexSetID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Index Set Not Found",
+				"Index set ID "+state.IndexSetID.ValueString()+" no longer exists, removing field mapping "+state.Field.ValueString()+" from state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Index Set Field Mapping",
+			"Could not read index set ID "+state.IndexSetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var mapping *client.CustomFieldMapping
+	for i := range indexSet.CustomFieldMappings {
+		if indexSet.CustomFieldMappings[i].Field == state.Field.ValueString() {
+			mapping = &indexSet.CustomFieldMappings[i]
+			break
+		}
+	}
+	if mapping == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.ID = types.StringValue(state.IndexSetID.ValueString() + "/" + mapping.Field)
+	state.Type = types.StringValue(mapping.Type)
+	if state.RotateImmediately.IsNull() {
+		state.RotateImmediately = types.BoolValue(false)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexSetFieldMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan indexSetFieldMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Change the field type
+	err := r.client.SetFieldType(ctx, fieldTypeChangeRequest(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Index Set Field Mapping",
+			"Could not set field type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexSetFieldMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexSetFieldMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove the custom field mapping via API
+	err := r.client.RemoveFieldTypes(ctx, &client.FieldTypeRemovalRequest{
+		IndexSets: []string{state.IndexSetID.ValueString()},
+		Fields:    []string{state.Field.ValueString()},
+		Rotate:    state.RotateImmediately.ValueBool(),
+	})
+	if err != nil {
+		// The index set was deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Index Set Field Mapping",
+			"Could not remove field type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from a '<index_set_id>/<field>' identifier.
+func (r *indexSetFieldMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <index_set_id>/<field>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index_set_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field"), parts[1])...)
+}
+
+// fieldTypeChangeRequest builds the API request from the model.
+func fieldTypeChangeRequest(plan *indexSetFieldMappingResourceModel) *client.FieldTypeChangeRequest {
+	return &client.FieldTypeChangeRequest{
+		IndexSets: []string{plan.IndexSetID.ValueString()},
+		Field:     plan.Field.ValueString(),
+		Type:      plan.Type.ValueString(),
+		Rotate:    plan.RotateImmediately.ValueBool(),
+	}
+}
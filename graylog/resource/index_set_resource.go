@@ -5,9 +5,13 @@ import (
 	"fmt"
 
 	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -17,9 +21,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &indexSetResource{}
-	_ resource.ResourceWithConfigure   = &indexSetResource{}
-	_ resource.ResourceWithImportState = &indexSetResource{}
+	_ resource.Resource                   = &indexSetResource{}
+	_ resource.ResourceWithConfigure      = &indexSetResource{}
+	_ resource.ResourceWithImportState    = &indexSetResource{}
+	_ resource.ResourceWithValidateConfig = &indexSetResource{}
+	_ resource.ResourceWithModifyPlan     = &indexSetResource{}
 )
 
 // NewIndexSetResource is a helper function to simplify the provider implementation.
@@ -34,19 +40,28 @@ type indexSetResource struct {
 
 // indexSetResourceModel maps the resource schema data.
 type indexSetResourceModel struct {
-	ID                                types.String `tfsdk:"id"`
-	Title                             types.String `tfsdk:"title"`
-	Description                       types.String `tfsdk:"description"`
-	IndexPrefix                       types.String `tfsdk:"index_prefix"`
-	Shards                            types.Int64  `tfsdk:"shards"`
-	Replicas                          types.Int64  `tfsdk:"replicas"`
-	RotationStrategyClass             types.String `tfsdk:"rotation_strategy_class"`
-	RetentionStrategyClass            types.String `tfsdk:"retention_strategy_class"`
-	IndexAnalyzer                     types.String `tfsdk:"index_analyzer"`
-	IndexOptimizationMaxNumSegments   types.Int64  `tfsdk:"index_optimization_max_num_segments"`
-	FieldTypeRefreshInterval          types.Int64  `tfsdk:"field_type_refresh_interval"`
-	Writable                          types.Bool   `tfsdk:"writable"`
-	Default                           types.Bool   `tfsdk:"default"`
+	ID                              types.String              `tfsdk:"id"`
+	Title                           types.String              `tfsdk:"title"`
+	Description                     types.String              `tfsdk:"description"`
+	IndexPrefix                     types.String              `tfsdk:"index_prefix"`
+	Shards                          types.Int64               `tfsdk:"shards"`
+	Replicas                        types.Int64               `tfsdk:"replicas"`
+	RotationStrategyClass           types.String              `tfsdk:"rotation_strategy_class"`
+	RetentionStrategyClass          types.String              `tfsdk:"retention_strategy_class"`
+	IndexAnalyzer                   types.String              `tfsdk:"index_analyzer"`
+	IndexOptimizationMaxNumSegments types.Int64               `tfsdk:"index_optimization_max_num_segments"`
+	IndexOptimizationDisabled       types.Bool                `tfsdk:"index_optimization_disabled"`
+	FieldTypeRefreshInterval        types.Int64               `tfsdk:"field_type_refresh_interval"`
+	Writable                        types.Bool                `tfsdk:"writable"`
+	Default                         types.Bool                `tfsdk:"default"`
+	Rotation                        *indexSetRotationModel    `tfsdk:"rotation"`
+	Retention                       *indexSetRetentionModel   `tfsdk:"retention"`
+	DataTiering                     *indexSetDataTieringModel `tfsdk:"data_tiering"`
+	UseLegacyRotation               types.Bool                `tfsdk:"use_legacy_rotation"`
+	IsDefault                       types.Bool                `tfsdk:"is_default"`
+	DeleteIndices                   types.Bool                `tfsdk:"delete_indices"`
+	FieldTypeProfile                types.String              `tfsdk:"field_type_profile"`
+	Timeouts                        timeouts.Value            `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -55,7 +70,7 @@ func (r *indexSetResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *indexSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *indexSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Graylog index set.",
 		Attributes: map[string]schema.Attribute{
@@ -96,13 +111,13 @@ func (r *indexSetResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Default:     int64default.StaticInt64(0),
 			},
 			"rotation_strategy_class": schema.StringAttribute{
-				Description: "The rotation strategy class.",
+				Description: "The rotation strategy class. Set from the rotation block when present.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("org.graylog2.indexer.rotation.strategies.TimeBasedSizeOptimizingStrategy"),
 			},
 			"retention_strategy_class": schema.StringAttribute{
-				Description: "The retention strategy class.",
+				Description: "The retention strategy class. Set from the retention block when present.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("org.graylog2.indexer.retention.strategies.DeletionRetentionStrategy"),
@@ -119,6 +134,12 @@ func (r *indexSetResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				Default:     int64default.StaticInt64(1),
 			},
+			"index_optimization_disabled": schema.BoolAttribute{
+				Description: "Whether Graylog skips optimizing indices after they are rotated.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"field_type_refresh_interval": schema.Int64Attribute{
 				Description: "Field type refresh interval in milliseconds.",
 				Optional:    true,
@@ -133,10 +154,139 @@ func (r *indexSetResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Whether this is the default index set.",
 				Computed:    true,
 			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default index set. Setting it to true makes this index set the default; to move the default away, set it on another index set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_type_profile": schema.StringAttribute{
+				Description: "The ID of the index field type profile applied to the indices of the index set.",
+				Optional:    true,
+			},
+			"delete_indices": schema.BoolAttribute{
+				Description: "Whether the indices of the index set are deleted when the index set is destroyed. Defaults to false, keeping the indices.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"use_legacy_rotation": schema.BoolAttribute{
+				Description: "Whether the rotation and retention strategies are used instead of data tiering. Defaults to true when a rotation or retention block is set without a data_tiering block.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rotation":     rotationBlock(),
+			"retention":    retentionBlock(),
+			"data_tiering": dataTieringBlock(),
+			"timeouts":     timeoutsBlock(ctx),
 		},
 	}
 }
 
+// ValidateConfig validates the rotation, retention and data_tiering blocks.
+func (r *indexSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config indexSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateStrategies(&config, &resp.Diagnostics)
+	validateDataTiering(&config, &resp.Diagnostics)
+}
+
+// ModifyPlan sets the strategy classes from the rotation and retention blocks,
+// infers use_legacy_rotation when it is not configured and refuses to unset
+// is_default on the default index set.
+func (r *indexSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan indexSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Rotation != nil {
+		if class, count := plan.Rotation.class(); count == 1 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotation_strategy_class"), class)...)
+		}
+	}
+	if plan.Retention != nil {
+		if class, count := plan.Retention.class(); count == 1 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("retention_strategy_class"), class)...)
+		}
+	}
+
+	var useLegacyRotation types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("use_legacy_rotation"), &useLegacyRotation)...)
+	if useLegacyRotation.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("use_legacy_rotation"), inferLegacyRotation(&plan))...)
+	}
+
+	// The default index set can only be moved by making another one the default
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var isDefault types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("default"), &isDefault)...)
+	if !plan.IsDefault.IsUnknown() && !plan.IsDefault.ValueBool() && isDefault.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_default"),
+			"Invalid Default Index Set",
+			"Index set "+plan.Title.ValueString()+" is the default index set. Set is_default on another index set to change the default.",
+		)
+	}
+}
+
+// indexSetStrategies returns the rotation and retention strategy configs of
+// the plan, and the data tiering config when legacy rotation is not used.
+func indexSetStrategies(plan *indexSetResourceModel) (map[string]interface{}, map[string]interface{}, map[string]interface{}) {
+	rotation := rotationStrategy(plan.Rotation, plan.RotationStrategyClass.ValueString())
+	retention := retentionStrategy(plan.Retention, plan.RetentionStrategyClass.ValueString())
+
+	// Graylog only applies the rotation and retention strategies with legacy
+	// rotation, otherwise data tiering takes over.
+	var dataTiering map[string]interface{}
+	if !plan.UseLegacyRotation.ValueBool() {
+		dataTiering = dataTieringConfig(plan.DataTiering)
+	}
+	return rotation, retention, dataTiering
+}
+
+// readStrategies maps the rotation and retention strategies and the data
+// tiering of the index set into the blocks tracked in state, or all blocks on
+// import.
+func readStrategies(state *indexSetResourceModel, indexSet *client.IndexSet, imported bool, diags *diag.Diagnostics) {
+	if state.Rotation != nil || imported {
+		state.Rotation = rotationModelFromStrategy(indexSet.RotationStrategyClass, indexSet.RotationStrategy)
+		if state.Rotation == nil && !imported {
+			diags.AddWarning(
+				"Unsupported Rotation Strategy",
+				"The rotation strategy '"+indexSet.RotationStrategyClass+"' cannot be represented by the rotation block.",
+			)
+		}
+	}
+	if state.Retention != nil || imported {
+		state.Retention = retentionModelFromStrategy(indexSet.RetentionStrategyClass, indexSet.RetentionStrategy)
+		if state.Retention == nil && !imported {
+			diags.AddWarning(
+				"Unsupported Retention Strategy",
+				"The retention strategy '"+indexSet.RetentionStrategyClass+"' cannot be represented by the retention block.",
+			)
+		}
+	}
+	if state.DataTiering != nil || (imported && !indexSet.UseLegacyRotation) {
+		state.DataTiering = dataTieringModelFromConfig(indexSet.DataTiering)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *indexSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -164,25 +314,17 @@ func (r *indexSetResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Build rotation strategy
-	rotationStrategy := map[string]interface{}{
-		"type":                 "org.graylog2.indexer.rotation.strategies.TimeBasedSizeOptimizingStrategyConfig",
-		"index_lifetime_min":   "P30D",
-		"index_lifetime_max":   "P40D",
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Build retention strategy
-	retentionStrategy := map[string]interface{}{
-		"type":                  "org.graylog2.indexer.retention.strategies.DeletionRetentionStrategyConfig",
-		"max_number_of_indices": 20,
-	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	// Build data tiering
-	dataTiering := map[string]interface{}{
-		"type":               "hot_only",
-		"index_lifetime_min": "P30D",
-		"index_lifetime_max": "P40D",
-	}
+	// Build rotation and retention strategies and data tiering
+	rotationStrategy, retentionStrategy, dataTiering := indexSetStrategies(&plan)
 
 	// Build the create request
 	createReq := &client.CreateIndexSetRequest{
@@ -197,15 +339,16 @@ func (r *indexSetResource) Create(ctx context.Context, req resource.CreateReques
 		RetentionStrategy:               retentionStrategy,
 		IndexAnalyzer:                   plan.IndexAnalyzer.ValueString(),
 		IndexOptimizationMaxNumSegments: int(plan.IndexOptimizationMaxNumSegments.ValueInt64()),
-		IndexOptimizationDisabled:       false,
+		IndexOptimizationDisabled:       plan.IndexOptimizationDisabled.ValueBool(),
 		FieldTypeRefreshInterval:        int(plan.FieldTypeRefreshInterval.ValueInt64()),
-		UseLegacyRotation:               false,
+		UseLegacyRotation:               plan.UseLegacyRotation.ValueBool(),
 		Writable:                        true,
 		DataTiering:                     dataTiering,
+		FieldTypeProfile:                plan.FieldTypeProfile.ValueStringPointer(),
 	}
 
 	// Create the index set
-	indexSet, err := r.client.CreateIndexSet(createReq)
+	indexSet, err := r.client.CreateIndexSet(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Index Set",
@@ -214,6 +357,18 @@ func (r *indexSetResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Make the index set the default one if requested
+	if plan.IsDefault.ValueBool() && !indexSet.Default {
+		indexSet, err = r.client.SetDefaultIndexSet(ctx, indexSet.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Default Index Set",
+				"Could not make index set "+plan.Title.ValueString()+" the default, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Map response to state
 	plan.ID = types.StringValue(indexSet.ID)
 	plan.Title = types.StringValue(indexSet.Title)
@@ -225,9 +380,16 @@ func (r *indexSetResource) Create(ctx context.Context, req resource.CreateReques
 	plan.RetentionStrategyClass = types.StringValue(indexSet.RetentionStrategyClass)
 	plan.IndexAnalyzer = types.StringValue(indexSet.IndexAnalyzer)
 	plan.IndexOptimizationMaxNumSegments = types.Int64Value(int64(indexSet.IndexOptimizationMaxNumSegments))
+	plan.IndexOptimizationDisabled = types.BoolValue(indexSet.IndexOptimizationDisabled)
 	plan.FieldTypeRefreshInterval = types.Int64Value(int64(indexSet.FieldTypeRefreshInterval))
 	plan.Writable = types.BoolValue(indexSet.Writable)
 	plan.Default = types.BoolValue(indexSet.Default)
+	plan.IsDefault = types.BoolValue(indexSet.Default)
+	plan.FieldTypeProfile = types.StringNull()
+	if indexSet.FieldTypeProfile != nil && *indexSet.FieldTypeProfile != "" {
+		plan.FieldTypeProfile = types.StringValue(*indexSet.FieldTypeProfile)
+	}
+	plan.UseLegacyRotation = types.BoolValue(indexSet.UseLegacyRotation)
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -243,9 +405,22 @@ func (r *indexSetResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get index set from API
-	indexSet, err := r.client.GetIndexSet(state.ID.ValueString())
+	indexSet, err := r.client.GetIndexSet(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Index Set",
 			"Could not read index set ID "+state.ID.ValueString()+": "+err.Error(),
@@ -254,6 +429,10 @@ func (r *indexSetResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Update state
+	imported := state.Title.IsNull()
+	if state.DeleteIndices.IsNull() {
+		state.DeleteIndices = types.BoolValue(false)
+	}
 	state.Title = types.StringValue(indexSet.Title)
 	state.Description = types.StringValue(indexSet.Description)
 	state.Shards = types.Int64Value(int64(indexSet.Shards))
@@ -262,9 +441,17 @@ func (r *indexSetResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.RetentionStrategyClass = types.StringValue(indexSet.RetentionStrategyClass)
 	state.IndexAnalyzer = types.StringValue(indexSet.IndexAnalyzer)
 	state.IndexOptimizationMaxNumSegments = types.Int64Value(int64(indexSet.IndexOptimizationMaxNumSegments))
+	state.IndexOptimizationDisabled = types.BoolValue(indexSet.IndexOptimizationDisabled)
 	state.FieldTypeRefreshInterval = types.Int64Value(int64(indexSet.FieldTypeRefreshInterval))
 	state.Writable = types.BoolValue(indexSet.Writable)
 	state.Default = types.BoolValue(indexSet.Default)
+	state.IsDefault = types.BoolValue(indexSet.Default)
+	state.FieldTypeProfile = types.StringNull()
+	if indexSet.FieldTypeProfile != nil && *indexSet.FieldTypeProfile != "" {
+		state.FieldTypeProfile = types.StringValue(*indexSet.FieldTypeProfile)
+	}
+	state.UseLegacyRotation = types.BoolValue(indexSet.UseLegacyRotation)
+	readStrategies(&state, indexSet, imported, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -280,19 +467,46 @@ func (r *indexSetResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Build rotation strategy
-	rotationStrategy := map[string]interface{}{
-		"type":               "org.graylog2.indexer.rotation.strategies.TimeBasedSizeOptimizingStrategyConfig",
-		"index_lifetime_min": "P30D",
-		"index_lifetime_max": "P40D",
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Build retention strategy
-	retentionStrategy := map[string]interface{}{
-		"type":                  "org.graylog2.indexer.retention.strategies.DeletionRetentionStrategyConfig",
-		"max_number_of_indices": 20,
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state indexSetResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default index set can only be moved by making another one the default,
+	// check the live index set as the default may have moved since the plan
+	if !plan.IsDefault.IsUnknown() && !plan.IsDefault.ValueBool() && state.Default.ValueBool() {
+		current, err := r.client.GetIndexSet(ctx, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Index Set",
+				"Could not read index set ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if current.Default {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_default"),
+				"Error Updating Index Set",
+				"Index set "+plan.Title.ValueString()+" is the default index set. Set is_default on another index set to change the default.",
+			)
+			return
+		}
 	}
 
+	// Build rotation and retention strategies and data tiering
+	rotationStrategy, retentionStrategy, dataTiering := indexSetStrategies(&plan)
+
 	// Build the update request
 	updateReq := &client.UpdateIndexSetRequest{
 		Title:                           plan.Title.ValueString(),
@@ -305,13 +519,15 @@ func (r *indexSetResource) Update(ctx context.Context, req resource.UpdateReques
 		RetentionStrategy:               retentionStrategy,
 		IndexAnalyzer:                   plan.IndexAnalyzer.ValueString(),
 		IndexOptimizationMaxNumSegments: int(plan.IndexOptimizationMaxNumSegments.ValueInt64()),
-		IndexOptimizationDisabled:       false,
+		IndexOptimizationDisabled:       plan.IndexOptimizationDisabled.ValueBool(),
 		FieldTypeRefreshInterval:        int(plan.FieldTypeRefreshInterval.ValueInt64()),
-		UseLegacyRotation:               false,
+		UseLegacyRotation:               plan.UseLegacyRotation.ValueBool(),
+		DataTiering:                     dataTiering,
+		FieldTypeProfile:                plan.FieldTypeProfile.ValueStringPointer(),
 	}
 
 	// Update the index set
-	indexSet, err := r.client.UpdateIndexSet(plan.ID.ValueString(), updateReq)
+	indexSet, err := r.client.UpdateIndexSet(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Index Set",
@@ -320,6 +536,18 @@ func (r *indexSetResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Make the index set the default one if requested
+	if plan.IsDefault.ValueBool() && !indexSet.Default {
+		indexSet, err = r.client.SetDefaultIndexSet(ctx, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Default Index Set",
+				"Could not make index set "+plan.Title.ValueString()+" the default, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Update state
 	plan.Title = types.StringValue(indexSet.Title)
 	plan.Description = types.StringValue(indexSet.Description)
@@ -329,9 +557,16 @@ func (r *indexSetResource) Update(ctx context.Context, req resource.UpdateReques
 	plan.RetentionStrategyClass = types.StringValue(indexSet.RetentionStrategyClass)
 	plan.IndexAnalyzer = types.StringValue(indexSet.IndexAnalyzer)
 	plan.IndexOptimizationMaxNumSegments = types.Int64Value(int64(indexSet.IndexOptimizationMaxNumSegments))
+	plan.IndexOptimizationDisabled = types.BoolValue(indexSet.IndexOptimizationDisabled)
 	plan.FieldTypeRefreshInterval = types.Int64Value(int64(indexSet.FieldTypeRefreshInterval))
 	plan.Writable = types.BoolValue(indexSet.Writable)
 	plan.Default = types.BoolValue(indexSet.Default)
+	plan.IsDefault = types.BoolValue(indexSet.Default)
+	plan.FieldTypeProfile = types.StringNull()
+	if indexSet.FieldTypeProfile != nil && *indexSet.FieldTypeProfile != "" {
+		plan.FieldTypeProfile = types.StringValue(*indexSet.FieldTypeProfile)
+	}
+	plan.UseLegacyRotation = types.BoolValue(indexSet.UseLegacyRotation)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -346,9 +581,46 @@ func (r *indexSetResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// Delete index set via API
-	err := r.client.DeleteIndexSet(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Graylog refuses to delete the default index set, check the live index set
+	// as another one may have been made the default in the same apply
+	if state.Default.ValueBool() {
+		current, err := r.client.GetIndexSet(ctx, state.ID.ValueString())
+		if err != nil {
+			// Already deleted outside of Terraform
+			if client.IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Index Set",
+				"Could not read index set ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if current.Default {
+			resp.Diagnostics.AddError(
+				"Error Deleting Index Set",
+				"Index set "+state.Title.ValueString()+" is the default index set. Set is_default on another index set before deleting it.",
+			)
+			return
+		}
+	}
+
+	// Delete index set via API, keeping its indices unless requested otherwise
+	err := r.client.DeleteIndexSet(ctx, state.ID.ValueString(), state.DeleteIndices.ValueBool())
 	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Index Set",
 			"Could not delete index set, unexpected error: "+err.Error(),
@@ -0,0 +1,568 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Rotation strategy classes of index sets
+const (
+	rotationTimeSizeOptimizingClass = "org.graylog2.indexer.rotation.strategies.TimeBasedSizeOptimizingStrategy"
+	rotationMessageCountClass       = "org.graylog2.indexer.rotation.strategies.MessageCountRotationStrategy"
+	rotationSizeClass               = "org.graylog2.indexer.rotation.strategies.SizeBasedRotationStrategy"
+	rotationTimeClass               = "org.graylog2.indexer.rotation.strategies.TimeBasedRotationStrategy"
+)
+
+// Retention strategy classes of index sets
+const (
+	retentionDeletionClass = "org.graylog2.indexer.retention.strategies.DeletionRetentionStrategy"
+	retentionClosingClass  = "org.graylog2.indexer.retention.strategies.ClosingRetentionStrategy"
+	retentionNoopClass     = "org.graylog2.indexer.retention.strategies.NoopRetentionStrategy"
+	retentionArchiveClass  = "org.graylog.plugins.archive.indexer.retention.strategies.ArchiveRetentionStrategy"
+)
+
+// Defaults of the strategy settings, matching the Graylog defaults
+const (
+	defaultIndexLifetimeMin   = "P30D"
+	defaultIndexLifetimeMax   = "P40D"
+	defaultMaxDocsPerIndex    = 20000000
+	defaultMaxIndexSize       = 1073741824
+	defaultRotationPeriod     = "P1D"
+	defaultMaxNumberOfIndices = 20
+	defaultArchiveIndexAction = "CLOSE"
+)
+
+// indexSetRotationModel maps the rotation block.
+type indexSetRotationModel struct {
+	TimeSizeOptimizing *indexSetTimeSizeOptimizingModel `tfsdk:"time_size_optimizing"`
+	MessageCount       *indexSetMessageCountModel       `tfsdk:"message_count"`
+	Size               *indexSetSizeModel               `tfsdk:"size"`
+	Time               *indexSetTimeRotationModel       `tfsdk:"time"`
+}
+
+// indexSetTimeSizeOptimizingModel maps the time_size_optimizing rotation block.
+type indexSetTimeSizeOptimizingModel struct {
+	IndexLifetimeMin types.String `tfsdk:"index_lifetime_min"`
+	IndexLifetimeMax types.String `tfsdk:"index_lifetime_max"`
+}
+
+// indexSetMessageCountModel maps the message_count rotation block.
+type indexSetMessageCountModel struct {
+	MaxDocsPerIndex types.Int64 `tfsdk:"max_docs_per_index"`
+}
+
+// indexSetSizeModel maps the size rotation block.
+type indexSetSizeModel struct {
+	MaxSize types.Int64 `tfsdk:"max_size"`
+}
+
+// indexSetTimeRotationModel maps the time rotation block.
+type indexSetTimeRotationModel struct {
+	RotationPeriod      types.String `tfsdk:"rotation_period"`
+	MaxRotationPeriod   types.String `tfsdk:"max_rotation_period"`
+	RotateEmptyIndexSet types.Bool   `tfsdk:"rotate_empty_index_set"`
+}
+
+// indexSetRetentionModel maps the retention block.
+type indexSetRetentionModel struct {
+	Deletion *indexSetMaxIndicesModel       `tfsdk:"deletion"`
+	Closing  *indexSetMaxIndicesModel       `tfsdk:"closing"`
+	Noop     *indexSetMaxIndicesModel       `tfsdk:"noop"`
+	Archive  *indexSetArchiveRetentionModel `tfsdk:"archive"`
+}
+
+// indexSetMaxIndicesModel maps the deletion, closing and noop retention blocks.
+type indexSetMaxIndicesModel struct {
+	MaxNumberOfIndices types.Int64 `tfsdk:"max_number_of_indices"`
+}
+
+// indexSetArchiveRetentionModel maps the archive retention block.
+type indexSetArchiveRetentionModel struct {
+	MaxNumberOfIndices types.Int64  `tfsdk:"max_number_of_indices"`
+	IndexAction        types.String `tfsdk:"index_action"`
+}
+
+// rotationBlock returns the schema of the rotation block.
+func rotationBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "The rotation strategy of the index set. Exactly one strategy block must be set. Sets rotation_strategy_class.",
+		Blocks: map[string]schema.Block{
+			"time_size_optimizing": schema.SingleNestedBlock{
+				Description: "Rotates indices by size, keeping their age between the lifetime bounds.",
+				Attributes: map[string]schema.Attribute{
+					"index_lifetime_min": schema.StringAttribute{
+						Description: "The minimum lifetime of an index as ISO 8601 period.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(defaultIndexLifetimeMin),
+					},
+					"index_lifetime_max": schema.StringAttribute{
+						Description: "The maximum lifetime of an index as ISO 8601 period.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(defaultIndexLifetimeMax),
+					},
+				},
+			},
+			"message_count": schema.SingleNestedBlock{
+				Description: "Rotates indices after a number of messages.",
+				Attributes: map[string]schema.Attribute{
+					"max_docs_per_index": schema.Int64Attribute{
+						Description: "The maximum number of messages per index.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(defaultMaxDocsPerIndex),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"size": schema.SingleNestedBlock{
+				Description: "Rotates indices when they reach a size.",
+				Attributes: map[string]schema.Attribute{
+					"max_size": schema.Int64Attribute{
+						Description: "The maximum size of an index in bytes.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(defaultMaxIndexSize),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"time": schema.SingleNestedBlock{
+				Description: "Rotates indices after a period of time.",
+				Attributes: map[string]schema.Attribute{
+					"rotation_period": schema.StringAttribute{
+						Description: "The rotation period as ISO 8601 period.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(defaultRotationPeriod),
+					},
+					"max_rotation_period": schema.StringAttribute{
+						Description: "The upper bound of the rotation period as ISO 8601 period.",
+						Optional:    true,
+					},
+					"rotate_empty_index_set": schema.BoolAttribute{
+						Description: "Whether indices are rotated when they contain no messages.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// retentionBlock returns the schema of the retention block.
+func retentionBlock() schema.SingleNestedBlock {
+	maxIndices := func(action string) schema.SingleNestedBlock {
+		return schema.SingleNestedBlock{
+			Description: action + " the oldest indices when there are more than max_number_of_indices.",
+			Attributes: map[string]schema.Attribute{
+				"max_number_of_indices": maxNumberOfIndicesAttribute(),
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "The retention strategy of the index set. Exactly one strategy block must be set. Sets retention_strategy_class.",
+		Blocks: map[string]schema.Block{
+			"deletion": maxIndices("Deletes"),
+			"closing":  maxIndices("Closes"),
+			"noop": schema.SingleNestedBlock{
+				Description: "Keeps all indices.",
+				Attributes: map[string]schema.Attribute{
+					"max_number_of_indices": maxNumberOfIndicesAttribute(),
+				},
+			},
+			"archive": schema.SingleNestedBlock{
+				Description: "Archives the oldest indices when there are more than max_number_of_indices. Requires Graylog Enterprise.",
+				Attributes: map[string]schema.Attribute{
+					"max_number_of_indices": maxNumberOfIndicesAttribute(),
+					"index_action": schema.StringAttribute{
+						Description: "What happens to archived indices (NONE, CLOSE or DELETE).",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(defaultArchiveIndexAction),
+						Validators: []validator.String{
+							stringvalidator.OneOf("NONE", "CLOSE", "DELETE"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// maxNumberOfIndicesAttribute returns the schema of the max_number_of_indices attribute.
+func maxNumberOfIndicesAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "The maximum number of indices kept.",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(defaultMaxNumberOfIndices),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// validateStrategies checks that the rotation and retention blocks select
+// exactly one strategy that agrees with the strategy class attributes.
+func validateStrategies(config *indexSetResourceModel, diags *diag.Diagnostics) {
+	if config.Rotation != nil {
+		class, count := config.Rotation.class()
+		validateStrategyBlock(path.Root("rotation"), path.Root("rotation_strategy_class"), config.RotationStrategyClass, class, count, diags)
+	}
+	if config.Retention != nil {
+		class, count := config.Retention.class()
+		validateStrategyBlock(path.Root("retention"), path.Root("retention_strategy_class"), config.RetentionStrategyClass, class, count, diags)
+	}
+}
+
+func validateStrategyBlock(blockPath, classPath path.Path, configured types.String, class string, count int, diags *diag.Diagnostics) {
+	if count != 1 {
+		diags.AddAttributeError(
+			blockPath,
+			"Invalid Strategy Block",
+			fmt.Sprintf("Exactly one strategy block must be set, got %d.", count),
+		)
+		return
+	}
+
+	if !configured.IsNull() && !configured.IsUnknown() && configured.ValueString() != class {
+		diags.AddAttributeError(
+			classPath,
+			"Conflicting Strategy Class",
+			"The strategy block selects '"+class+"', but the class is configured as '"+configured.ValueString()+"'.",
+		)
+	}
+}
+
+// class returns the strategy class selected by the rotation block and the
+// number of strategy blocks set.
+func (m *indexSetRotationModel) class() (string, int) {
+	var class string
+	count := 0
+	if m.TimeSizeOptimizing != nil {
+		class, count = rotationTimeSizeOptimizingClass, count+1
+	}
+	if m.MessageCount != nil {
+		class, count = rotationMessageCountClass, count+1
+	}
+	if m.Size != nil {
+		class, count = rotationSizeClass, count+1
+	}
+	if m.Time != nil {
+		class, count = rotationTimeClass, count+1
+	}
+	return class, count
+}
+
+// class returns the strategy class selected by the retention block and the
+// number of strategy blocks set.
+func (m *indexSetRetentionModel) class() (string, int) {
+	var class string
+	count := 0
+	if m.Deletion != nil {
+		class, count = retentionDeletionClass, count+1
+	}
+	if m.Closing != nil {
+		class, count = retentionClosingClass, count+1
+	}
+	if m.Noop != nil {
+		class, count = retentionNoopClass, count+1
+	}
+	if m.Archive != nil {
+		class, count = retentionArchiveClass, count+1
+	}
+	return class, count
+}
+
+// rotationStrategy returns the rotation strategy config for the rotation
+// block, or the default config of the class when no block is set.
+func rotationStrategy(rotation *indexSetRotationModel, class string) map[string]interface{} {
+	if rotation == nil {
+		rotation = defaultRotationModel(class)
+		if rotation == nil {
+			return map[string]interface{}{"type": class + "Config"}
+		}
+	}
+
+	class, _ = rotation.class()
+	config := map[string]interface{}{
+		"type": class + "Config",
+	}
+	switch {
+	case rotation.TimeSizeOptimizing != nil:
+		config["index_lifetime_min"] = rotation.TimeSizeOptimizing.IndexLifetimeMin.ValueString()
+		config["index_lifetime_max"] = rotation.TimeSizeOptimizing.IndexLifetimeMax.ValueString()
+	case rotation.MessageCount != nil:
+		config["max_docs_per_index"] = rotation.MessageCount.MaxDocsPerIndex.ValueInt64()
+	case rotation.Size != nil:
+		config["max_size"] = rotation.Size.MaxSize.ValueInt64()
+	case rotation.Time != nil:
+		config["rotation_period"] = rotation.Time.RotationPeriod.ValueString()
+		config["max_rotation_period"] = nil
+		if !rotation.Time.MaxRotationPeriod.IsNull() {
+			config["max_rotation_period"] = rotation.Time.MaxRotationPeriod.ValueString()
+		}
+		config["rotate_empty_index_set"] = rotation.Time.RotateEmptyIndexSet.ValueBool()
+	}
+	return config
+}
+
+// retentionStrategy returns the retention strategy config for the retention
+// block, or the default config of the class when no block is set.
+func retentionStrategy(retention *indexSetRetentionModel, class string) map[string]interface{} {
+	if retention == nil {
+		retention = defaultRetentionModel(class)
+		if retention == nil {
+			return map[string]interface{}{"type": class + "Config"}
+		}
+	}
+
+	class, _ = retention.class()
+	config := map[string]interface{}{
+		"type": class + "Config",
+	}
+	switch {
+	case retention.Deletion != nil:
+		config["max_number_of_indices"] = retention.Deletion.MaxNumberOfIndices.ValueInt64()
+	case retention.Closing != nil:
+		config["max_number_of_indices"] = retention.Closing.MaxNumberOfIndices.ValueInt64()
+	case retention.Noop != nil:
+		config["max_number_of_indices"] = retention.Noop.MaxNumberOfIndices.ValueInt64()
+	case retention.Archive != nil:
+		config["max_number_of_indices"] = retention.Archive.MaxNumberOfIndices.ValueInt64()
+		config["index_action"] = retention.Archive.IndexAction.ValueString()
+	}
+	return config
+}
+
+// defaultRotationModel returns the rotation block with Graylog defaults for a
+// strategy class, or nil for unknown classes.
+func defaultRotationModel(class string) *indexSetRotationModel {
+	return rotationModelFromStrategy(class, map[string]interface{}{
+		"index_lifetime_min": defaultIndexLifetimeMin,
+		"index_lifetime_max": defaultIndexLifetimeMax,
+		"max_docs_per_index": float64(defaultMaxDocsPerIndex),
+		"max_size":           float64(defaultMaxIndexSize),
+		"rotation_period":    defaultRotationPeriod,
+	})
+}
+
+// defaultRetentionModel returns the retention block with Graylog defaults for
+// a strategy class, or nil for unknown classes.
+func defaultRetentionModel(class string) *indexSetRetentionModel {
+	return retentionModelFromStrategy(class, map[string]interface{}{
+		"max_number_of_indices": float64(defaultMaxNumberOfIndices),
+		"index_action":          defaultArchiveIndexAction,
+	})
+}
+
+// rotationModelFromStrategy converts a rotation strategy returned by Graylog
+// into the rotation block, or nil for unknown classes.
+func rotationModelFromStrategy(class string, config map[string]interface{}) *indexSetRotationModel {
+	switch class {
+	case rotationTimeSizeOptimizingClass:
+		return &indexSetRotationModel{TimeSizeOptimizing: &indexSetTimeSizeOptimizingModel{
+			IndexLifetimeMin: types.StringValue(stringFromConfig(config["index_lifetime_min"])),
+			IndexLifetimeMax: types.StringValue(stringFromConfig(config["index_lifetime_max"])),
+		}}
+	case rotationMessageCountClass:
+		return &indexSetRotationModel{MessageCount: &indexSetMessageCountModel{
+			MaxDocsPerIndex: types.Int64Value(int64FromConfig(config["max_docs_per_index"])),
+		}}
+	case rotationSizeClass:
+		return &indexSetRotationModel{Size: &indexSetSizeModel{
+			MaxSize: types.Int64Value(int64FromConfig(config["max_size"])),
+		}}
+	case rotationTimeClass:
+		model := &indexSetTimeRotationModel{
+			RotationPeriod:      types.StringValue(stringFromConfig(config["rotation_period"])),
+			MaxRotationPeriod:   types.StringNull(),
+			RotateEmptyIndexSet: types.BoolValue(config["rotate_empty_index_set"] == true),
+		}
+		if value, ok := config["max_rotation_period"].(string); ok {
+			model.MaxRotationPeriod = types.StringValue(value)
+		}
+		return &indexSetRotationModel{Time: model}
+	}
+	return nil
+}
+
+// retentionModelFromStrategy converts a retention strategy returned by
+// Graylog into the retention block, or nil for unknown classes.
+func retentionModelFromStrategy(class string, config map[string]interface{}) *indexSetRetentionModel {
+	maxIndices := &indexSetMaxIndicesModel{
+		MaxNumberOfIndices: types.Int64Value(int64FromConfig(config["max_number_of_indices"])),
+	}
+
+	switch class {
+	case retentionDeletionClass:
+		return &indexSetRetentionModel{Deletion: maxIndices}
+	case retentionClosingClass:
+		return &indexSetRetentionModel{Closing: maxIndices}
+	case retentionNoopClass:
+		return &indexSetRetentionModel{Noop: maxIndices}
+	case retentionArchiveClass:
+		return &indexSetRetentionModel{Archive: &indexSetArchiveRetentionModel{
+			MaxNumberOfIndices: maxIndices.MaxNumberOfIndices,
+			IndexAction:        types.StringValue(stringFromConfig(config["index_action"])),
+		}}
+	}
+	return nil
+}
+
+// indexSetDataTieringModel maps the data_tiering block.
+type indexSetDataTieringModel struct {
+	Type                  types.String `tfsdk:"type"`
+	IndexLifetimeMin      types.String `tfsdk:"index_lifetime_min"`
+	IndexLifetimeMax      types.String `tfsdk:"index_lifetime_max"`
+	WarmTierEnabled       types.Bool   `tfsdk:"warm_tier_enabled"`
+	ArchiveBeforeDeletion types.Bool   `tfsdk:"archive_before_deletion"`
+}
+
+// dataTieringBlock returns the schema of the data_tiering block.
+func dataTieringBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "The data tiering of the index set, replacing rotation and retention when use_legacy_rotation is false. Requires Graylog 6 or newer.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The data tiering type ('hot_only', or 'hot_warm' with Graylog Enterprise).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(dataTieringHotOnly),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataTieringHotOnly, dataTieringHotWarm),
+				},
+			},
+			"index_lifetime_min": schema.StringAttribute{
+				Description: "The minimum lifetime of the data as ISO 8601 period.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultIndexLifetimeMin),
+			},
+			"index_lifetime_max": schema.StringAttribute{
+				Description: "The maximum lifetime of the data as ISO 8601 period, after which it is deleted.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultIndexLifetimeMax),
+			},
+			"warm_tier_enabled": schema.BoolAttribute{
+				Description: "Whether data is moved to the warm tier. Requires the 'hot_warm' type.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"archive_before_deletion": schema.BoolAttribute{
+				Description: "Whether data is archived before it is deleted. Requires Graylog Enterprise.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Data tiering types of index sets
+const (
+	dataTieringHotOnly = "hot_only"
+	dataTieringHotWarm = "hot_warm"
+)
+
+// validateDataTiering checks the data_tiering block against the legacy
+// rotation setting.
+func validateDataTiering(config *indexSetResourceModel, diags *diag.Diagnostics) {
+	legacy := config.UseLegacyRotation
+	if config.DataTiering != nil {
+		if legacy.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("data_tiering"),
+				"Conflicting Data Tiering",
+				"The data_tiering block cannot be used with use_legacy_rotation enabled.",
+			)
+		}
+
+		tiering := config.DataTiering
+		if tiering.WarmTierEnabled.ValueBool() && !tiering.Type.IsUnknown() && tiering.Type.ValueString() != dataTieringHotWarm {
+			diags.AddAttributeError(
+				path.Root("data_tiering").AtName("warm_tier_enabled"),
+				"Invalid Data Tiering",
+				"The warm tier requires the 'hot_warm' data tiering type.",
+			)
+		}
+		return
+	}
+
+	if (config.Rotation != nil || config.Retention != nil) && !legacy.IsNull() && !legacy.IsUnknown() && !legacy.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("use_legacy_rotation"),
+			"Rotation And Retention Not Applied",
+			"Graylog applies the rotation and retention blocks only with use_legacy_rotation enabled.",
+		)
+	}
+}
+
+// inferLegacyRotation returns whether legacy rotation is used when
+// use_legacy_rotation is not configured: rotation and retention blocks
+// without a data_tiering block select it.
+func inferLegacyRotation(plan *indexSetResourceModel) bool {
+	return (plan.Rotation != nil || plan.Retention != nil) && plan.DataTiering == nil
+}
+
+// dataTieringConfig returns the data tiering config for the data_tiering
+// block, or the hot-only default when no block is set.
+func dataTieringConfig(tiering *indexSetDataTieringModel) map[string]interface{} {
+	if tiering == nil {
+		return map[string]interface{}{
+			"type":               dataTieringHotOnly,
+			"index_lifetime_min": defaultIndexLifetimeMin,
+			"index_lifetime_max": defaultIndexLifetimeMax,
+		}
+	}
+
+	config := map[string]interface{}{
+		"type":               tiering.Type.ValueString(),
+		"index_lifetime_min": tiering.IndexLifetimeMin.ValueString(),
+		"index_lifetime_max": tiering.IndexLifetimeMax.ValueString(),
+	}
+	if tiering.Type.ValueString() == dataTieringHotWarm {
+		config["warm_tier_enabled"] = tiering.WarmTierEnabled.ValueBool()
+	}
+	if tiering.ArchiveBeforeDeletion.ValueBool() {
+		config["archive_before_deletion"] = true
+	}
+	return config
+}
+
+// dataTieringModelFromConfig converts the data tiering returned by Graylog
+// into the data_tiering block.
+func dataTieringModelFromConfig(config map[string]interface{}) *indexSetDataTieringModel {
+	if config == nil {
+		return nil
+	}
+
+	return &indexSetDataTieringModel{
+		Type:                  types.StringValue(stringFromConfig(config["type"])),
+		IndexLifetimeMin:      types.StringValue(stringFromConfig(config["index_lifetime_min"])),
+		IndexLifetimeMax:      types.StringValue(stringFromConfig(config["index_lifetime_max"])),
+		WarmTierEnabled:       types.BoolValue(config["warm_tier_enabled"] == true),
+		ArchiveBeforeDeletion: types.BoolValue(config["archive_before_deletion"] == true),
+	}
+}
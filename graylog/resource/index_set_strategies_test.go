@@ -0,0 +1,281 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiRoundTrip encodes a strategy config to JSON and decodes it again, as
+// Graylog returns it
+func apiRoundTrip(t *testing.T, config map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Failed to unmarshal config: %v", err)
+	}
+
+	return result
+}
+
+// TestRotationStrategyRoundTrip tests the conversion of rotation blocks into
+// strategy configs and back
+func TestRotationStrategyRoundTrip(t *testing.T) {
+	tests := []struct {
+		name          string
+		rotation      *indexSetRotationModel
+		expectedClass string
+		expectedKeys  map[string]interface{}
+	}{
+		{
+			name: "Time size optimizing",
+			rotation: &indexSetRotationModel{TimeSizeOptimizing: &indexSetTimeSizeOptimizingModel{
+				IndexLifetimeMin: types.StringValue("P7D"),
+				IndexLifetimeMax: types.StringValue("P14D"),
+			}},
+			expectedClass: rotationTimeSizeOptimizingClass,
+			expectedKeys: map[string]interface{}{
+				"index_lifetime_min": "P7D",
+				"index_lifetime_max": "P14D",
+			},
+		},
+		{
+			name: "Message count",
+			rotation: &indexSetRotationModel{MessageCount: &indexSetMessageCountModel{
+				MaxDocsPerIndex: types.Int64Value(1000000),
+			}},
+			expectedClass: rotationMessageCountClass,
+			expectedKeys: map[string]interface{}{
+				"max_docs_per_index": float64(1000000),
+			},
+		},
+		{
+			name: "Size",
+			rotation: &indexSetRotationModel{Size: &indexSetSizeModel{
+				MaxSize: types.Int64Value(5368709120),
+			}},
+			expectedClass: rotationSizeClass,
+			expectedKeys: map[string]interface{}{
+				"max_size": float64(5368709120),
+			},
+		},
+		{
+			name: "Time",
+			rotation: &indexSetRotationModel{Time: &indexSetTimeRotationModel{
+				RotationPeriod:      types.StringValue("PT6H"),
+				MaxRotationPeriod:   types.StringValue("P1D"),
+				RotateEmptyIndexSet: types.BoolValue(true),
+			}},
+			expectedClass: rotationTimeClass,
+			expectedKeys: map[string]interface{}{
+				"rotation_period":        "PT6H",
+				"max_rotation_period":    "P1D",
+				"rotate_empty_index_set": true,
+			},
+		},
+		{
+			name: "Time without max rotation period",
+			rotation: &indexSetRotationModel{Time: &indexSetTimeRotationModel{
+				RotationPeriod:      types.StringValue("P1D"),
+				MaxRotationPeriod:   types.StringNull(),
+				RotateEmptyIndexSet: types.BoolValue(false),
+			}},
+			expectedClass: rotationTimeClass,
+			expectedKeys: map[string]interface{}{
+				"rotation_period":        "P1D",
+				"max_rotation_period":    nil,
+				"rotate_empty_index_set": false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := apiRoundTrip(t, rotationStrategy(tt.rotation, ""))
+
+			if config["type"] != tt.expectedClass+"Config" {
+				t.Errorf("Expected type %sConfig, got %v", tt.expectedClass, config["type"])
+			}
+			for key, expected := range tt.expectedKeys {
+				value, exists := config[key]
+				if !exists {
+					t.Errorf("Expected key %s in config", key)
+					continue
+				}
+				if value != expected {
+					t.Errorf("Expected %s to be %v, got %v", key, expected, value)
+				}
+			}
+
+			model := rotationModelFromStrategy(tt.expectedClass, config)
+			if !reflect.DeepEqual(model, tt.rotation) {
+				t.Errorf("Expected model %+v, got %+v", tt.rotation, model)
+			}
+		})
+	}
+}
+
+// TestRetentionStrategyRoundTrip tests the conversion of retention blocks
+// into strategy configs and back
+func TestRetentionStrategyRoundTrip(t *testing.T) {
+	maxIndices := &indexSetMaxIndicesModel{MaxNumberOfIndices: types.Int64Value(10)}
+
+	tests := []struct {
+		name          string
+		retention     *indexSetRetentionModel
+		expectedClass string
+		expectedKeys  map[string]interface{}
+	}{
+		{
+			name:          "Deletion",
+			retention:     &indexSetRetentionModel{Deletion: maxIndices},
+			expectedClass: retentionDeletionClass,
+			expectedKeys:  map[string]interface{}{"max_number_of_indices": float64(10)},
+		},
+		{
+			name:          "Closing",
+			retention:     &indexSetRetentionModel{Closing: maxIndices},
+			expectedClass: retentionClosingClass,
+			expectedKeys:  map[string]interface{}{"max_number_of_indices": float64(10)},
+		},
+		{
+			name:          "Noop",
+			retention:     &indexSetRetentionModel{Noop: maxIndices},
+			expectedClass: retentionNoopClass,
+			expectedKeys:  map[string]interface{}{"max_number_of_indices": float64(10)},
+		},
+		{
+			name: "Archive",
+			retention: &indexSetRetentionModel{Archive: &indexSetArchiveRetentionModel{
+				MaxNumberOfIndices: types.Int64Value(10),
+				IndexAction:        types.StringValue("DELETE"),
+			}},
+			expectedClass: retentionArchiveClass,
+			expectedKeys: map[string]interface{}{
+				"max_number_of_indices": float64(10),
+				"index_action":          "DELETE",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := apiRoundTrip(t, retentionStrategy(tt.retention, ""))
+
+			if config["type"] != tt.expectedClass+"Config" {
+				t.Errorf("Expected type %sConfig, got %v", tt.expectedClass, config["type"])
+			}
+			for key, expected := range tt.expectedKeys {
+				if value := config[key]; value != expected {
+					t.Errorf("Expected %s to be %v, got %v", key, expected, value)
+				}
+			}
+
+			model := retentionModelFromStrategy(tt.expectedClass, config)
+			if !reflect.DeepEqual(model, tt.retention) {
+				t.Errorf("Expected model %+v, got %+v", tt.retention, model)
+			}
+		})
+	}
+}
+
+// TestStrategyDefaults tests the strategy configs used when no block is set
+func TestStrategyDefaults(t *testing.T) {
+	rotation := rotationStrategy(nil, rotationMessageCountClass)
+	if rotation["max_docs_per_index"] != int64(defaultMaxDocsPerIndex) {
+		t.Errorf("Expected max_docs_per_index %d, got %v", defaultMaxDocsPerIndex, rotation["max_docs_per_index"])
+	}
+
+	retention := retentionStrategy(nil, retentionDeletionClass)
+	if retention["max_number_of_indices"] != int64(defaultMaxNumberOfIndices) {
+		t.Errorf("Expected max_number_of_indices %d, got %v", defaultMaxNumberOfIndices, retention["max_number_of_indices"])
+	}
+
+	unknown := rotationStrategy(nil, "org.example.CustomRotationStrategy")
+	if len(unknown) != 1 || unknown["type"] != "org.example.CustomRotationStrategyConfig" {
+		t.Errorf("Expected only the type for unknown classes, got %v", unknown)
+	}
+
+	if model := rotationModelFromStrategy("org.example.CustomRotationStrategy", map[string]interface{}{}); model != nil {
+		t.Errorf("Expected nil rotation model for unknown class, got %+v", model)
+	}
+	if model := retentionModelFromStrategy("org.example.CustomRetentionStrategy", map[string]interface{}{}); model != nil {
+		t.Errorf("Expected nil retention model for unknown class, got %+v", model)
+	}
+}
+
+// TestValidateStrategyBlock tests the validation of the strategy blocks
+func TestValidateStrategyBlock(t *testing.T) {
+	tests := []struct {
+		name        string
+		rotation    *indexSetRotationModel
+		configured  types.String
+		expectError bool
+	}{
+		{
+			name:        "No strategy block",
+			rotation:    &indexSetRotationModel{},
+			configured:  types.StringNull(),
+			expectError: true,
+		},
+		{
+			name: "Two strategy blocks",
+			rotation: &indexSetRotationModel{
+				MessageCount: &indexSetMessageCountModel{MaxDocsPerIndex: types.Int64Value(1000)},
+				Size:         &indexSetSizeModel{MaxSize: types.Int64Value(1000)},
+			},
+			configured:  types.StringNull(),
+			expectError: true,
+		},
+		{
+			name:        "One strategy block",
+			rotation:    &indexSetRotationModel{Size: &indexSetSizeModel{MaxSize: types.Int64Value(1000)}},
+			configured:  types.StringNull(),
+			expectError: false,
+		},
+		{
+			name:        "Matching strategy class",
+			rotation:    &indexSetRotationModel{Size: &indexSetSizeModel{MaxSize: types.Int64Value(1000)}},
+			configured:  types.StringValue(rotationSizeClass),
+			expectError: false,
+		},
+		{
+			name:        "Unknown strategy class",
+			rotation:    &indexSetRotationModel{Size: &indexSetSizeModel{MaxSize: types.Int64Value(1000)}},
+			configured:  types.StringUnknown(),
+			expectError: false,
+		},
+		{
+			name:        "Conflicting strategy class",
+			rotation:    &indexSetRotationModel{Size: &indexSetSizeModel{MaxSize: types.Int64Value(1000)}},
+			configured:  types.StringValue(rotationTimeClass),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			class, count := tt.rotation.class()
+			validateStrategyBlock(path.Root("rotation"), path.Root("rotation_strategy_class"), tt.configured, class, count, &diags)
+
+			if tt.expectError && !diags.HasError() {
+				t.Errorf("Expected error but got none")
+			}
+
+			if !tt.expectError && diags.HasError() {
+				t.Errorf("Unexpected error: %v", diags)
+			}
+		})
+	}
+}
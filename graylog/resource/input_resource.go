@@ -3,23 +3,33 @@ package resource
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"sort"
+	"strings"
+	"time"
 
 	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &inputResource{}
-	_ resource.ResourceWithConfigure   = &inputResource{}
-	_ resource.ResourceWithImportState = &inputResource{}
+	_ resource.Resource                   = &inputResource{}
+	_ resource.ResourceWithConfigure      = &inputResource{}
+	_ resource.ResourceWithImportState    = &inputResource{}
+	_ resource.ResourceWithValidateConfig = &inputResource{}
+	_ resource.ResourceWithModifyPlan     = &inputResource{}
 )
 
 // NewInputResource is a helper function to simplify the provider implementation.
@@ -34,21 +44,36 @@ type inputResource struct {
 
 // inputResourceModel maps the resource schema data.
 type inputResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Title      types.String `tfsdk:"title"`
-	Type       types.String `tfsdk:"type"`
-	Global     types.Bool   `tfsdk:"global"`
-	Node       types.String `tfsdk:"node"`
-	Attributes types.Map    `tfsdk:"attributes"`
+	ID                  types.String   `tfsdk:"id"`
+	Title               types.String   `tfsdk:"title"`
+	Type                types.String   `tfsdk:"type"`
+	Global              types.Bool     `tfsdk:"global"`
+	Node                types.String   `tfsdk:"node"`
+	Attributes          types.Map      `tfsdk:"attributes"`
+	SensitiveAttributes types.Map      `tfsdk:"sensitive_attributes"`
+	AttributesWo        types.Map      `tfsdk:"attributes_wo"`
+	AttributesWoVersion types.Int64    `tfsdk:"attributes_wo_version"`
+	DesiredState        types.String   `tfsdk:"desired_state"`
+	NodeStates          types.Map      `tfsdk:"node_states"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
+// Desired states of an input
+const (
+	inputDesiredRunning = "running"
+	inputDesiredStopped = "stopped"
+)
+
+// inputStatePollInterval is the delay between two input state checks.
+const inputStatePollInterval = 2 * time.Second
+
 // Metadata returns the resource type name.
 func (r *inputResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_input"
 }
 
 // Schema defines the schema for the resource.
-func (r *inputResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *inputResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Graylog input.",
 		Attributes: map[string]schema.Attribute{
@@ -81,12 +106,45 @@ func (r *inputResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 			},
 			"attributes": schema.MapAttribute{
-				Description: "Configuration attributes for the input. The required attributes vary by input type.",
+				Description: "Configuration attributes for the input. The supported and required attributes vary by input type and are checked against the input type at plan time. Values are converted to the type Graylog declares for them; list values are comma-separated. Values must be written in the form Graylog returns them in, e.g. 'true', '8' or 'a,b'.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"sensitive_attributes": schema.MapAttribute{
+				Description: "Configuration attributes holding passwords, e.g. 'password' of an input type that does not encrypt it. Stored in state but never shown in plans.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"attributes_wo": schema.MapAttribute{
+				Description: "Secret configuration attributes for the input, e.g. 'tls_key_password'. Never stored in state. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+			},
+			"attributes_wo_version": schema.Int64Attribute{
+				Description: "Version of attributes_wo. Encrypted attributes are only sent to Graylog on create and when this value changes.",
+				Optional:    true,
+			},
+			"desired_state": schema.StringAttribute{
+				Description: "Whether the input should be 'running' or 'stopped'. Defaults to 'running'.",
 				Optional:    true,
 				Computed:    true,
+				Default:     stringdefault.StaticString(inputDesiredRunning),
+				Validators: []validator.String{
+					stringvalidator.OneOf(inputDesiredRunning, inputDesiredStopped),
+				},
+			},
+			"node_states": schema.MapAttribute{
+				Description: "The runtime state of the input on each node it is present on, keyed by node ID (e.g. 'RUNNING', 'STOPPED', 'FAILED').",
+				Computed:    true,
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -108,6 +166,84 @@ func (r *inputResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ValidateConfig checks that an attribute is not configured in more than one
+// attribute map.
+func (r *inputResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config inputResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maps := []struct {
+		name  string
+		value types.Map
+	}{
+		{"attributes", config.Attributes},
+		{"sensitive_attributes", config.SensitiveAttributes},
+		{"attributes_wo", config.AttributesWo},
+	}
+
+	seen := make(map[string]string)
+	for _, m := range maps {
+		if m.value.IsNull() || m.value.IsUnknown() {
+			continue
+		}
+		for key := range m.value.Elements() {
+			if other, exists := seen[key]; exists {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(m.name).AtMapKey(key),
+					"Conflicting Input Attribute",
+					"The attribute '"+key+"' cannot be set in both "+other+" and "+m.name+".",
+				)
+				continue
+			}
+			seen[key] = m.name
+		}
+	}
+}
+
+// ModifyPlan checks the attributes against the requested configuration of
+// the input type.
+func (r *inputResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan inputResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Type.IsUnknown() {
+		return
+	}
+
+	// attributes is computed, so use the configured maps
+	var config inputResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var secretKeys []string
+	if !config.AttributesWo.IsNull() && !config.AttributesWo.IsUnknown() {
+		for key := range config.AttributesWo.Elements() {
+			secretKeys = append(secretKeys, key)
+		}
+	}
+
+	typeInfo, err := r.client.GetInputType(ctx, plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Error Reading Input Type",
+			"Could not read input type "+plan.Type.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	validateInputAttributes(typeInfo, config.Attributes, config.SensitiveAttributes, secretKeys, &resp.Diagnostics)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *inputResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan inputResourceModel
@@ -117,33 +253,45 @@ func (r *inputResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Build configuration from attributes map
-	configuration := map[string]interface{}{}
-	
-	if !plan.Attributes.IsNull() {
-		attributesMap := make(map[string]string)
-		diags = plan.Attributes.ElementsAs(ctx, &attributesMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		
-		for key, value := range attributesMap {
-			// Convert numeric fields to integers
-			if key == "port" || key == "recv_buffer_size" || key == "number_worker_threads" {
-				if intVal, err := strconv.Atoi(value); err == nil {
-					configuration[key] = intVal
-				} else {
-					configuration[key] = value
-				}
-			} else if value == "true" {
-				configuration[key] = true
-			} else if value == "false" {
-				configuration[key] = false
-			} else {
-				configuration[key] = value
-			}
-		}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Get the requested configuration of the input type
+	typeInfo, err := r.client.GetInputType(ctx, plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Input Type",
+			"Could not read input type "+plan.Type.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Build configuration from the attributes maps
+	attributes, diags := inputAttributes(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configuration, diags := inputConfiguration(typeInfo, attributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the write-only secrets
+	secrets, diags := r.inputSecrets(ctx, req.Config, typeInfo, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range secrets {
+		configuration[key] = value
 	}
 
 	// Build the create request
@@ -159,7 +307,7 @@ func (r *inputResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create the input
-	input, err := r.client.CreateInput(createReq)
+	input, err := r.client.CreateInput(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Input",
@@ -176,9 +324,41 @@ func (r *inputResource) Create(ctx context.Context, req resource.CreateRequest,
 	if input.Node != "" {
 		plan.Node = types.StringValue(input.Node)
 	}
+	plan.NodeStates = types.MapNull(types.StringType)
 
 	// Keep the attributes from the plan - don't replace with API response
 	// The API may return additional default values we didn't request
+	if plan.Attributes.IsUnknown() {
+		plan.Attributes = types.MapNull(types.StringType)
+	}
+
+	// Graylog starts new inputs, stop it if requested
+	desiredState := plan.DesiredState.ValueString()
+	if desiredState == inputDesiredStopped {
+		if err := r.client.StopInput(ctx, input.ID); err != nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error Stopping Input",
+				"Could not stop input ID "+input.ID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Wait until the input reached its desired state on every node
+	states, err := r.waitForInputState(ctx, input.ID, desiredState)
+	if states != nil {
+		plan.NodeStates, diags = inputNodeStates(ctx, states)
+		resp.Diagnostics.Append(diags...)
+	}
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		resp.Diagnostics.AddError(
+			"Error Waiting For Input State",
+			"Input ID "+input.ID+" did not reach the desired state '"+desiredState+"': "+err.Error(),
+		)
+		return
+	}
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -194,9 +374,22 @@ func (r *inputResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get input from API
-	input, err := r.client.GetInput(state.ID.ValueString())
+	input, err := r.client.GetInput(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Input",
 			"Could not read input ID "+state.ID.ValueString()+": "+err.Error(),
@@ -226,7 +419,7 @@ func (r *inputResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		updatedAttrs := make(map[string]string)
 		for key := range currentAttrs {
 			if value, exists := input.Attributes[key]; exists {
-				updatedAttrs[key] = fmt.Sprintf("%v", value)
+				updatedAttrs[key] = inputAttributeString(value)
 			}
 		}
 
@@ -239,6 +432,47 @@ func (r *inputResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
+	// Keep the sensitive attributes that are still set, Graylog masks their values
+	if !state.SensitiveAttributes.IsNull() {
+		sensitiveAttrs := make(map[string]string)
+		diags = state.SensitiveAttributes.ElementsAs(ctx, &sensitiveAttrs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for key := range sensitiveAttrs {
+			if _, exists := input.Attributes[key]; !exists {
+				delete(sensitiveAttrs, key)
+			}
+		}
+
+		sensitiveValue, diags := types.MapValueFrom(ctx, types.StringType, sensitiveAttrs)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			state.SensitiveAttributes = sensitiveValue
+		}
+	}
+
+	// Get the runtime state of the input on each node
+	states, err := r.client.GetInputStates(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Input State",
+			"Could not read state of input ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.NodeStates, diags = inputNodeStates(ctx, states)
+	resp.Diagnostics.Append(diags...)
+	if desiredState := inputDesiredState(states); desiredState != "" {
+		state.DesiredState = types.StringValue(desiredState)
+	} else if state.DesiredState.IsNull() {
+		// Not present on any node, so not running anywhere
+		state.DesiredState = types.StringValue(inputDesiredStopped)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -253,33 +487,48 @@ func (r *inputResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Build configuration
-	configuration := map[string]interface{}{}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Convert plan.Attributes map to configuration map
-	if !plan.Attributes.IsNull() && !plan.Attributes.IsUnknown() {
-		attributesMap := make(map[string]string)
-		diags = plan.Attributes.ElementsAs(ctx, &attributesMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		for key, value := range attributesMap {
-			// Convert numeric fields to integers
-			if key == "port" || key == "recv_buffer_size" || key == "number_worker_threads" {
-				if intVal, err := strconv.Atoi(value); err == nil {
-					configuration[key] = intVal
-				} else {
-					configuration[key] = value
-				}
-			} else if value == "true" {
-				configuration[key] = true
-			} else if value == "false" {
-				configuration[key] = false
-			} else {
-				configuration[key] = value
-			}
-		}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the requested configuration of the input type
+	typeInfo, err := r.client.GetInputType(ctx, plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Input Type",
+			"Could not read input type "+plan.Type.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Build configuration from the attributes maps
+	attributes, diags := inputAttributes(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configuration, diags := inputConfiguration(typeInfo, attributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the write-only secrets, keeping the encrypted ones unless the version changed
+	var state inputResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	secrets, diags := r.inputSecrets(ctx, req.Config, typeInfo, secretsChanged(state.AttributesWoVersion, plan.AttributesWoVersion))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range secrets {
+		configuration[key] = value
 	}
 
 	// Build the update request
@@ -295,7 +544,7 @@ func (r *inputResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update the input
-	input, err := r.client.UpdateInput(plan.ID.ValueString(), updateReq)
+	input, err := r.client.UpdateInput(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Input",
@@ -314,6 +563,41 @@ func (r *inputResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Keep the attributes from the plan - don't replace with API response
 	// The API may return additional default values we didn't request
+	if plan.Attributes.IsUnknown() {
+		plan.Attributes = types.MapNull(types.StringType)
+	}
+
+	// Apply the desired state. Updating an input restarts it, so a stopped
+	// input is stopped again.
+	desiredState := plan.DesiredState.ValueString()
+	if desiredState == inputDesiredStopped {
+		err = r.client.StopInput(ctx, plan.ID.ValueString())
+	} else if state.DesiredState.ValueString() == inputDesiredStopped {
+		err = r.client.StartInput(ctx, plan.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Input State",
+			"Could not set input ID "+plan.ID.ValueString()+" to '"+desiredState+"', unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Wait until the restarted input reached its desired state on every node
+	plan.NodeStates = types.MapNull(types.StringType)
+	states, err := r.waitForInputState(ctx, plan.ID.ValueString(), desiredState)
+	if states != nil {
+		plan.NodeStates, diags = inputNodeStates(ctx, states)
+		resp.Diagnostics.Append(diags...)
+	}
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		resp.Diagnostics.AddError(
+			"Error Waiting For Input State",
+			"Input ID "+plan.ID.ValueString()+" did not reach the desired state '"+desiredState+"': "+err.Error(),
+		)
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -328,9 +612,22 @@ func (r *inputResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete input via API
-	err := r.client.DeleteInput(state.ID.ValueString())
+	err := r.client.DeleteInput(ctx, state.ID.ValueString())
 	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Input",
 			"Could not delete input, unexpected error: "+err.Error(),
@@ -344,3 +641,133 @@ func (r *inputResource) ImportState(ctx context.Context, req resource.ImportStat
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// inputSecrets converts attributes_wo into configuration values. Fields the
+// input type declares as encrypted use Graylog's encrypted value protocol;
+// other fields have no such protocol and are always sent.
+func (r *inputResource) inputSecrets(ctx context.Context, config tfsdk.Config, typeInfo *client.InputType, changed bool) (map[string]interface{}, diag.Diagnostics) {
+	secrets, diags := writeOnlyStringMap(ctx, config, path.Root("attributes_wo"))
+	if diags.HasError() || len(secrets) == 0 {
+		return nil, diags
+	}
+
+	encrypted := make(map[string]string)
+	values := make(map[string]interface{}, len(secrets))
+	for key, value := range secrets {
+		if field, ok := typeInfo.RequestedConfiguration[key]; ok && field.IsEncrypted {
+			encrypted[key] = value
+			continue
+		}
+		values[key] = value
+	}
+	for key, value := range encryptedValues(encrypted, changed) {
+		values[key] = value
+	}
+
+	return values, diags
+}
+
+// inputAttributes merges the attributes and sensitive_attributes maps.
+func inputAttributes(ctx context.Context, plan inputResourceModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attributes := make(map[string]string)
+
+	for _, value := range []types.Map{plan.Attributes, plan.SensitiveAttributes} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		values := make(map[string]string)
+		diags.Append(value.ElementsAs(ctx, &values, false)...)
+		for key, v := range values {
+			attributes[key] = v
+		}
+	}
+
+	return attributes, diags
+}
+
+// waitForInputState polls the input states until the input reached the
+// desired state on every node. It fails as soon as a node reports the input
+// as FAILED or when ctx is done, and returns the last states seen.
+func (r *inputResource) waitForInputState(ctx context.Context, inputID, desiredState string) (map[string]client.InputState, error) {
+	for {
+		states, err := r.client.GetInputStates(ctx, inputID)
+		if err != nil {
+			return nil, err
+		}
+
+		done := true
+		for _, nodeID := range sortedNodeIDs(states) {
+			state := states[nodeID]
+			switch state.State {
+			case client.InputStateFailed:
+				return states, fmt.Errorf("input failed on node %s: %s", nodeID, state.DetailedMessage)
+			case client.InputStateRunning:
+				done = done && desiredState == inputDesiredRunning
+			case client.InputStateStopped:
+				done = done && desiredState == inputDesiredStopped
+			default:
+				done = false
+			}
+		}
+		// A running input shows up on its nodes once it was launched
+		if len(states) == 0 && desiredState == inputDesiredRunning {
+			done = false
+		}
+		if done {
+			return states, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return states, fmt.Errorf("%w, current states: %s", ctx.Err(), inputStatesSummary(states))
+		case <-time.After(inputStatePollInterval):
+		}
+	}
+}
+
+// inputDesiredState derives the desired state from the node states. An input
+// that is starting or failed on a node is meant to be running. Returns an
+// empty string when the input is not present on any node.
+func inputDesiredState(states map[string]client.InputState) string {
+	if len(states) == 0 {
+		return ""
+	}
+	for _, state := range states {
+		if state.State != client.InputStateStopped {
+			return inputDesiredRunning
+		}
+	}
+	return inputDesiredStopped
+}
+
+// inputNodeStates converts the node states into the node_states map.
+func inputNodeStates(ctx context.Context, states map[string]client.InputState) (types.Map, diag.Diagnostics) {
+	nodeStates := make(map[string]string, len(states))
+	for nodeID, state := range states {
+		nodeStates[nodeID] = state.State
+	}
+	return types.MapValueFrom(ctx, types.StringType, nodeStates)
+}
+
+// inputStatesSummary formats the node states for error messages.
+func inputStatesSummary(states map[string]client.InputState) string {
+	if len(states) == 0 {
+		return "not present on any node"
+	}
+	parts := make([]string, 0, len(states))
+	for _, nodeID := range sortedNodeIDs(states) {
+		parts = append(parts, nodeID+"="+states[nodeID].State)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sortedNodeIDs returns the sorted node IDs of the node states.
+func sortedNodeIDs(states map[string]client.InputState) []string {
+	nodeIDs := make([]string, 0, len(states))
+	for nodeID := range states {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+	return nodeIDs
+}
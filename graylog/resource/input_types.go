@@ -0,0 +1,234 @@
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Configuration field types of Graylog input types
+const (
+	inputFieldText     = "text"
+	inputFieldNumber   = "number"
+	inputFieldBoolean  = "boolean"
+	inputFieldDropdown = "dropdown"
+	inputFieldList     = "list"
+)
+
+// inputFieldPasswordAttribute marks text fields holding a password.
+const inputFieldPasswordAttribute = "is_password"
+
+// isPasswordField reports whether a configuration field holds a secret.
+func isPasswordField(field client.InputConfigurationField) bool {
+	if field.IsEncrypted {
+		return true
+	}
+	for _, attribute := range field.Attributes {
+		if attribute == inputFieldPasswordAttribute {
+			return true
+		}
+	}
+	return false
+}
+
+// inputAttributeValue converts an attribute value to the type declared by its
+// configuration field.
+func inputAttributeValue(field client.InputConfigurationField, value string) (interface{}, error) {
+	switch field.Type {
+	case inputFieldNumber:
+		if converted, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return converted, nil
+		}
+		converted, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number, got %q", value)
+		}
+		return converted, nil
+	case inputFieldBoolean:
+		converted, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be true or false, got %q", value)
+		}
+		return converted, nil
+	case inputFieldList:
+		items := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+	return value, nil
+}
+
+// canonicalInputAttribute returns the form Graylog returns an attribute value
+// in, e.g. "8" for "08" or "a,b" for "a, b".
+func canonicalInputAttribute(field client.InputConfigurationField, value string) (string, error) {
+	converted, err := inputAttributeValue(field, value)
+	if err != nil {
+		return "", err
+	}
+
+	switch v := converted.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []string:
+		return strings.Join(v, ","), nil
+	}
+	return value, nil
+}
+
+// inputAttributeString converts an attribute value returned by Graylog into
+// its string form.
+func inputAttributeString(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, inputAttributeString(item))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// inputConfiguration converts the attributes into the configuration sent to
+// Graylog. Required fields that are not set are sent with their default value.
+// Values only known at apply time are checked here instead of at plan time.
+func inputConfiguration(typeInfo *client.InputType, attributes map[string]string) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration := make(map[string]interface{}, len(attributes))
+	for key, value := range attributes {
+		field, ok := typeInfo.RequestedConfiguration[key]
+		if !ok {
+			configuration[key] = value
+			continue
+		}
+		converted, err := inputAttributeValue(field, value)
+		if err != nil {
+			diags.AddError(
+				"Invalid Input Attribute",
+				fmt.Sprintf("The attribute %q of input type %q %s.", key, typeInfo.Type, err.Error()),
+			)
+			continue
+		}
+		configuration[key] = converted
+	}
+
+	for key, field := range typeInfo.RequestedConfiguration {
+		if _, ok := configuration[key]; !ok && !field.IsOptional && field.DefaultValue != nil {
+			configuration[key] = field.DefaultValue
+		}
+	}
+
+	return configuration, diags
+}
+
+// validateInputAttributes checks the attributes of an input against the
+// requested configuration of its type: unknown keys, values not matching
+// their type or not in canonical form, required keys without default value and passwords in plain
+// attributes. Unknown values are only checked for their key.
+func validateInputAttributes(typeInfo *client.InputType, attributes, sensitiveAttributes types.Map, secretKeys []string, diags *diag.Diagnostics) {
+	configured := make(map[string]bool)
+
+	check := func(attributePath path.Path, values types.Map, sensitive bool) {
+		if values.IsNull() || values.IsUnknown() {
+			return
+		}
+		for key, value := range values.Elements() {
+			configured[key] = true
+			field, ok := typeInfo.RequestedConfiguration[key]
+			if !ok {
+				diags.AddAttributeError(
+					attributePath.AtMapKey(key),
+					"Unknown Input Attribute",
+					fmt.Sprintf("The attribute %q is not supported by input type %q. Supported attributes: %s.", key, typeInfo.Type, strings.Join(inputFieldNames(typeInfo), ", ")),
+				)
+				continue
+			}
+			if !sensitive && isPasswordField(field) {
+				diags.AddAttributeWarning(
+					attributePath.AtMapKey(key),
+					"Password In Input Attributes",
+					fmt.Sprintf("The attribute %q holds a password and is shown in plans. Set it in sensitive_attributes or attributes_wo instead.", key),
+				)
+			}
+			str, ok := value.(types.String)
+			if !ok || str.IsNull() || str.IsUnknown() {
+				continue
+			}
+			canonical, err := canonicalInputAttribute(field, str.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					attributePath.AtMapKey(key),
+					"Invalid Input Attribute",
+					fmt.Sprintf("The attribute %q of input type %q %s.", key, typeInfo.Type, err.Error()),
+				)
+				continue
+			}
+			// Graylog returns values in their canonical form, others would never converge
+			if canonical != str.ValueString() {
+				diags.AddAttributeError(
+					attributePath.AtMapKey(key),
+					"Invalid Input Attribute",
+					fmt.Sprintf("The attribute %q of input type %q must be written as %q, the form Graylog returns it in.", key, typeInfo.Type, canonical),
+				)
+			}
+		}
+	}
+
+	check(path.Root("attributes"), attributes, false)
+	check(path.Root("sensitive_attributes"), sensitiveAttributes, true)
+
+	for _, key := range secretKeys {
+		configured[key] = true
+		if _, ok := typeInfo.RequestedConfiguration[key]; !ok {
+			diags.AddAttributeError(
+				path.Root("attributes_wo").AtMapKey(key),
+				"Unknown Input Attribute",
+				fmt.Sprintf("The attribute %q is not supported by input type %q.", key, typeInfo.Type),
+			)
+		}
+	}
+
+	// Missing keys can only be told apart once every map is known
+	if attributes.IsUnknown() || sensitiveAttributes.IsUnknown() {
+		return
+	}
+	for _, key := range inputFieldNames(typeInfo) {
+		field := typeInfo.RequestedConfiguration[key]
+		if !configured[key] && !field.IsOptional && field.DefaultValue == nil {
+			diags.AddAttributeError(
+				path.Root("attributes"),
+				"Missing Input Attribute",
+				fmt.Sprintf("Input type %q requires the attribute %q (%s).", typeInfo.Type, key, field.HumanName),
+			)
+		}
+	}
+}
+
+// inputFieldNames returns the sorted configuration field names of an input type.
+func inputFieldNames(typeInfo *client.InputType) []string {
+	names := make([]string, 0, len(typeInfo.RequestedConfiguration))
+	for name := range typeInfo.RequestedConfiguration {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
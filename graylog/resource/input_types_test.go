@@ -0,0 +1,262 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testInputType is an input type with a field of every type
+var testInputType = &client.InputType{
+	Type: "org.graylog2.inputs.syslog.udp.SyslogUDPInput",
+	RequestedConfiguration: map[string]client.InputConfigurationField{
+		"bind_address":  {Type: inputFieldText, DefaultValue: "0.0.0.0"},
+		"port":          {Type: inputFieldNumber},
+		"store_full":    {Type: inputFieldBoolean, IsOptional: true},
+		"charset_name":  {Type: inputFieldDropdown, IsOptional: true},
+		"allowed_hosts": {Type: inputFieldList, IsOptional: true},
+		"password":      {Type: inputFieldText, IsOptional: true, IsEncrypted: true},
+	},
+}
+
+// TestInputAttributeRoundTrip tests that canonical attribute values are read
+// back unchanged after being sent to Graylog
+func TestInputAttributeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name          string
+		fieldType     string
+		value         string
+		expectedValue interface{}
+	}{
+		{name: "Text", fieldType: inputFieldText, value: "0.0.0.0", expectedValue: "0.0.0.0"},
+		{name: "Dropdown", fieldType: inputFieldDropdown, value: "UTF-8", expectedValue: "UTF-8"},
+		{name: "Integer", fieldType: inputFieldNumber, value: "5140", expectedValue: int64(5140)},
+		{name: "Negative integer", fieldType: inputFieldNumber, value: "-1", expectedValue: int64(-1)},
+		{name: "Float", fieldType: inputFieldNumber, value: "0.5", expectedValue: 0.5},
+		{name: "Boolean", fieldType: inputFieldBoolean, value: "true", expectedValue: true},
+		{name: "List", fieldType: inputFieldList, value: "a,b", expectedValue: []string{"a", "b"}},
+		{name: "Empty list", fieldType: inputFieldList, value: "", expectedValue: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := client.InputConfigurationField{Type: tt.fieldType}
+			converted, err := inputAttributeValue(field, tt.value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(converted, tt.expectedValue) {
+				t.Errorf("Expected %#v, got %#v", tt.expectedValue, converted)
+			}
+
+			canonical, err := canonicalInputAttribute(field, tt.value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if canonical != tt.value {
+				t.Errorf("Expected %q to be canonical, got %q", tt.value, canonical)
+			}
+
+			// Graylog returns the value as decoded JSON
+			data, err := json.Marshal(converted)
+			if err != nil {
+				t.Fatalf("Failed to marshal value: %v", err)
+			}
+			var returned interface{}
+			if err := json.Unmarshal(data, &returned); err != nil {
+				t.Fatalf("Failed to unmarshal value: %v", err)
+			}
+			if got := inputAttributeString(returned); got != tt.value {
+				t.Errorf("Expected %q to be read back, got %q", tt.value, got)
+			}
+		})
+	}
+}
+
+// TestCanonicalInputAttribute tests the canonical form of attribute values
+func TestCanonicalInputAttribute(t *testing.T) {
+	tests := []struct {
+		name        string
+		fieldType   string
+		value       string
+		expected    string
+		expectError bool
+	}{
+		{name: "Leading zero", fieldType: inputFieldNumber, value: "08", expected: "8"},
+		{name: "Trailing zero", fieldType: inputFieldNumber, value: "1.50", expected: "1.5"},
+		{name: "Explicit sign", fieldType: inputFieldNumber, value: "+3", expected: "3"},
+		{name: "Capitalized boolean", fieldType: inputFieldBoolean, value: "True", expected: "true"},
+		{name: "Numeric boolean", fieldType: inputFieldBoolean, value: "1", expected: "true"},
+		{name: "List with spaces", fieldType: inputFieldList, value: "a, b", expected: "a,b"},
+		{name: "List with empty items", fieldType: inputFieldList, value: "a,,b,", expected: "a,b"},
+		{name: "Text with spaces", fieldType: inputFieldText, value: " a ", expected: " a "},
+		{name: "Invalid number", fieldType: inputFieldNumber, value: "ten", expectError: true},
+		{name: "Invalid boolean", fieldType: inputFieldBoolean, value: "yes", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := canonicalInputAttribute(client.InputConfigurationField{Type: tt.fieldType}, tt.value)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if canonical != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, canonical)
+			}
+		})
+	}
+}
+
+// TestInputConfiguration tests the configuration sent to Graylog
+func TestInputConfiguration(t *testing.T) {
+	t.Run("Converted values and defaults", func(t *testing.T) {
+		configuration, diags := inputConfiguration(testInputType, map[string]string{
+			"port":          "5140",
+			"store_full":    "false",
+			"allowed_hosts": "a,b",
+			"custom":        "value",
+		})
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		expected := map[string]interface{}{
+			"bind_address":  "0.0.0.0",
+			"port":          int64(5140),
+			"store_full":    false,
+			"allowed_hosts": []string{"a", "b"},
+			"custom":        "value",
+		}
+		if !reflect.DeepEqual(configuration, expected) {
+			t.Errorf("Expected %v, got %v", expected, configuration)
+		}
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		_, diags := inputConfiguration(testInputType, map[string]string{"port": "any"})
+		if !diags.HasError() {
+			t.Errorf("Expected error but got none")
+		}
+	})
+}
+
+// TestValidateInputAttributes tests the validation of input attributes
+// against the requested configuration of the input type
+func TestValidateInputAttributes(t *testing.T) {
+	attributes := func(values map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name          string
+		attributes    types.Map
+		sensitive     types.Map
+		secretKeys    []string
+		expectSummary string
+		expectWarning bool
+	}{
+		{
+			name: "Valid attributes",
+			attributes: attributes(map[string]attr.Value{
+				"port":          types.StringValue("5140"),
+				"allowed_hosts": types.StringValue("a,b"),
+			}),
+			sensitive: types.MapNull(types.StringType),
+		},
+		{
+			name:          "Unknown attribute",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("5140"), "custom": types.StringValue("1")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectSummary: "Unknown Input Attribute",
+		},
+		{
+			name:          "Missing required attribute",
+			attributes:    attributes(map[string]attr.Value{"store_full": types.StringValue("true")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectSummary: "Missing Input Attribute",
+		},
+		{
+			name:          "Invalid number",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("any")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectSummary: "Invalid Input Attribute",
+		},
+		{
+			name:          "Non-canonical number",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("05140")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectSummary: "Invalid Input Attribute",
+		},
+		{
+			name:          "Non-canonical boolean",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("5140"), "store_full": types.StringValue("True")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectSummary: "Invalid Input Attribute",
+		},
+		{
+			name:          "Non-canonical list",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("5140"), "allowed_hosts": types.StringValue("a, b")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectSummary: "Invalid Input Attribute",
+		},
+		{
+			name:       "Unknown value",
+			attributes: attributes(map[string]attr.Value{"port": types.StringUnknown()}),
+			sensitive:  types.MapNull(types.StringType),
+		},
+		{
+			name:          "Password in plain attributes",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("5140"), "password": types.StringValue("secret")}),
+			sensitive:     types.MapNull(types.StringType),
+			expectWarning: true,
+		},
+		{
+			name:       "Password in sensitive attributes",
+			attributes: attributes(map[string]attr.Value{"port": types.StringValue("5140")}),
+			sensitive:  attributes(map[string]attr.Value{"password": types.StringValue("secret")}),
+		},
+		{
+			name:          "Unknown write-only attribute",
+			attributes:    attributes(map[string]attr.Value{"port": types.StringValue("5140")}),
+			sensitive:     types.MapNull(types.StringType),
+			secretKeys:    []string{"token"},
+			expectSummary: "Unknown Input Attribute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateInputAttributes(testInputType, tt.attributes, tt.sensitive, tt.secretKeys, &diags)
+
+			if tt.expectSummary == "" && diags.HasError() {
+				t.Errorf("Unexpected error: %v", diags)
+			}
+			if tt.expectSummary != "" {
+				if diags.ErrorsCount() != 1 {
+					t.Fatalf("Expected 1 error, got %v", diags)
+				}
+				if summary := diags.Errors()[0].Summary(); summary != tt.expectSummary {
+					t.Errorf("Expected error %q, got %q", tt.expectSummary, summary)
+				}
+			}
+
+			if tt.expectWarning != (diags.WarningsCount() > 0) {
+				t.Errorf("Expected warning %t, got %v", tt.expectWarning, diags.Warnings())
+			}
+		})
+	}
+}
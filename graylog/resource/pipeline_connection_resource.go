@@ -0,0 +1,277 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pipelineConnectionResource{}
+	_ resource.ResourceWithConfigure   = &pipelineConnectionResource{}
+	_ resource.ResourceWithImportState = &pipelineConnectionResource{}
+)
+
+// NewPipelineConnectionResource is a helper function to simplify the provider implementation.
+func NewPipelineConnectionResource() resource.Resource {
+	return &pipelineConnectionResource{}
+}
+
+// pipelineConnectionResource is the resource implementation.
+type pipelineConnectionResource struct {
+	client *client.Client
+}
+
+// pipelineConnectionResourceModel maps the resource schema data.
+type pipelineConnectionResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	StreamID    types.String   `tfsdk:"stream_id"`
+	PipelineIDs types.Set      `tfsdk:"pipeline_ids"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *pipelineConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *pipelineConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the set of pipelines connected to a Graylog stream.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the connection, equal to the stream ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stream_id": schema.StringAttribute{
+				Description: "The ID of the stream the pipelines are connected to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pipeline_ids": schema.SetAttribute{
+				Description: "The IDs of the pipelines processing messages of the stream.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pipelineConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *pipelineConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var pipelineIDs []string
+	diags = plan.PipelineIDs.ElementsAs(ctx, &pipelineIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connect the pipelines
+	connection, err := r.client.ConnectPipelinesToStream(ctx, plan.StreamID.ValueString(), pipelineIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Pipeline Connection",
+			"Could not connect pipelines to stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(connection.StreamID)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *pipelineConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// The connection is gone with its stream
+	if _, err := r.client.GetStream(ctx, state.ID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Pipeline Connection",
+			"Could not read stream ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Look the connection up through the listing, streams without pipelines have none
+	connections, err := r.client.ListPipelineConnections(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pipeline Connection",
+			"Could not read pipeline connection of stream ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	pipelineIDs := []string{}
+	for _, connection := range connections {
+		if connection.StreamID == state.ID.ValueString() {
+			pipelineIDs = append(pipelineIDs, connection.PipelineIDs...)
+		}
+	}
+
+	// Update state
+	state.StreamID = state.ID
+	pipelineIDsValue, diags := types.SetValueFrom(ctx, types.StringType, pipelineIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.PipelineIDs = pipelineIDsValue
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *pipelineConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var pipelineIDs []string
+	diags = plan.PipelineIDs.ElementsAs(ctx, &pipelineIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the connected pipelines
+	_, err := r.client.ConnectPipelinesToStream(ctx, plan.StreamID.ValueString(), pipelineIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Pipeline Connection",
+			"Could not connect pipelines to stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *pipelineConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Disconnect all pipelines from the stream
+	_, err := r.client.ConnectPipelinesToStream(ctx, state.StreamID.ValueString(), []string{})
+	if err != nil {
+		// The stream was deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Pipeline Connection",
+			"Could not disconnect pipelines from stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state using the stream ID.
+func (r *pipelineConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,309 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"terraform-provider-graylog/graylog/rulelang"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &pipelineRuleResource{}
+	_ resource.ResourceWithConfigure      = &pipelineRuleResource{}
+	_ resource.ResourceWithImportState    = &pipelineRuleResource{}
+	_ resource.ResourceWithValidateConfig = &pipelineRuleResource{}
+)
+
+// NewPipelineRuleResource is a helper function to simplify the provider implementation.
+func NewPipelineRuleResource() resource.Resource {
+	return &pipelineRuleResource{}
+}
+
+// pipelineRuleResource is the resource implementation.
+type pipelineRuleResource struct {
+	client *client.Client
+}
+
+// pipelineRuleResourceModel maps the resource schema data.
+type pipelineRuleResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Title       types.String   `tfsdk:"title"`
+	Description types.String   `tfsdk:"description"`
+	Source      types.String   `tfsdk:"source"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *pipelineRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *pipelineRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Graylog pipeline processing rule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the pipeline rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the pipeline rule, taken from the rule name in the source.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the pipeline rule.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"source": schema.StringAttribute{
+				Description: "The rule source code, e.g. 'rule \"name\" when ... then ... end'. Stored verbatim.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pipelineRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the rule syntax offline so errors surface during validate.
+func (r *pipelineRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var source types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("source"), &source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || source.IsNull() || source.IsUnknown() {
+		return
+	}
+
+	for _, err := range rulelang.Validate(source.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid Pipeline Rule Source",
+			"The pipeline rule source has a syntax error at "+err.Error(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *pipelineRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the create request
+	createReq := &client.PipelineSourceRequest{
+		Description: plan.Description.ValueString(),
+		Source:      plan.Source.ValueString(),
+	}
+
+	// Create the pipeline rule
+	rule, err := r.client.CreatePipelineRule(ctx, createReq)
+	if err != nil {
+		addPipelineSourceError(&resp.Diagnostics, "Error Creating Pipeline Rule", "Could not create pipeline rule", err)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(rule.ID)
+	plan.Title = types.StringValue(rule.Title)
+	plan.Description = types.StringValue(rule.Description)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *pipelineRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get pipeline rule from API
+	rule, err := r.client.GetPipelineRule(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Pipeline Rule",
+			"Could not read pipeline rule ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	state.Title = types.StringValue(rule.Title)
+	state.Description = types.StringValue(rule.Description)
+	state.Source = pipelineSourceValue(state.Source, rule.Source)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *pipelineRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Build the update request
+	updateReq := &client.PipelineSourceRequest{
+		Description: plan.Description.ValueString(),
+		Source:      plan.Source.ValueString(),
+	}
+
+	// Update the pipeline rule
+	rule, err := r.client.UpdatePipelineRule(ctx, plan.ID.ValueString(), updateReq)
+	if err != nil {
+		addPipelineSourceError(&resp.Diagnostics, "Error Updating Pipeline Rule", "Could not update pipeline rule", err)
+		return
+	}
+
+	// Update state
+	plan.Title = types.StringValue(rule.Title)
+	plan.Description = types.StringValue(rule.Description)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *pipelineRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete pipeline rule via API
+	err := r.client.DeletePipelineRule(ctx, state.ID.ValueString())
+	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Pipeline Rule",
+			"Could not delete pipeline rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *pipelineRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// addPipelineSourceError reports parser errors returned by Graylog against the
+// source attribute and any other failure as a generic error.
+func addPipelineSourceError(diags *diag.Diagnostics, summary, detail string, err error) {
+	var sourceErr *client.PipelineSourceError
+	if !errors.As(err, &sourceErr) {
+		diags.AddError(summary, detail+", unexpected error: "+err.Error())
+		return
+	}
+
+	for _, parseErr := range sourceErr.Errors {
+		diags.AddAttributeError(
+			path.Root("source"),
+			summary,
+			detail+", Graylog reported a parse error at "+parseErr.String(),
+		)
+	}
+}
+
+// pipelineSourceValue keeps the configured source verbatim unless Graylog
+// holds a different program, ignoring surrounding whitespace.
+func pipelineSourceValue(current types.String, remote string) types.String {
+	if !current.IsNull() && strings.TrimSpace(current.ValueString()) == strings.TrimSpace(remote) {
+		return current
+	}
+	return types.StringValue(remote)
+}
@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"context"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlyStringMap reads a write-only map attribute. Write-only values are
+// only available in the configuration, never in the plan or state.
+func writeOnlyStringMap(ctx context.Context, config tfsdk.Config, attribute path.Path) (map[string]string, diag.Diagnostics) {
+	var value types.Map
+	diags := config.GetAttribute(ctx, attribute, &value)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	values := make(map[string]string)
+	diags.Append(value.ElementsAs(ctx, &values, false)...)
+	return values, diags
+}
+
+// secretsChanged reports whether write-only secrets must be sent again on
+// update, which is the case whenever the version attribute changes.
+func secretsChanged(state, plan types.Int64) bool {
+	return !state.Equal(plan)
+}
+
+// encryptedValues converts write-only secrets into encrypted values, keeping
+// the secrets stored by Graylog unless they changed.
+func encryptedValues(secrets map[string]string, changed bool) map[string]interface{} {
+	values := make(map[string]interface{}, len(secrets))
+	for key, secret := range secrets {
+		if changed {
+			values[key] = client.SetEncryptedValue(secret)
+		} else {
+			values[key] = client.KeepEncryptedValue()
+		}
+	}
+	return values
+}
@@ -0,0 +1,151 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestEncryptedValues tests whether write-only secrets replace or keep the
+// secrets stored by Graylog
+func TestEncryptedValues(t *testing.T) {
+	secrets := map[string]string{"basic_auth": "user:password", "api_secret": "secret"}
+
+	tests := []struct {
+		name     string
+		state    types.Int64
+		plan     types.Int64
+		expected map[string]interface{}
+	}{
+		{
+			name:  "Unchanged version",
+			state: types.Int64Value(1),
+			plan:  types.Int64Value(1),
+			expected: map[string]interface{}{
+				"basic_auth": client.KeepEncryptedValue(),
+				"api_secret": client.KeepEncryptedValue(),
+			},
+		},
+		{
+			name:  "Unversioned secrets",
+			state: types.Int64Null(),
+			plan:  types.Int64Null(),
+			expected: map[string]interface{}{
+				"basic_auth": client.KeepEncryptedValue(),
+				"api_secret": client.KeepEncryptedValue(),
+			},
+		},
+		{
+			name:  "Changed version",
+			state: types.Int64Value(1),
+			plan:  types.Int64Value(2),
+			expected: map[string]interface{}{
+				"basic_auth": client.SetEncryptedValue("user:password"),
+				"api_secret": client.SetEncryptedValue("secret"),
+			},
+		},
+		{
+			name:  "Version added",
+			state: types.Int64Null(),
+			plan:  types.Int64Value(1),
+			expected: map[string]interface{}{
+				"basic_auth": client.SetEncryptedValue("user:password"),
+				"api_secret": client.SetEncryptedValue("secret"),
+			},
+		},
+		{
+			name:  "Version removed",
+			state: types.Int64Value(1),
+			plan:  types.Int64Null(),
+			expected: map[string]interface{}{
+				"basic_auth": client.SetEncryptedValue("user:password"),
+				"api_secret": client.SetEncryptedValue("secret"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := encryptedValues(secrets, secretsChanged(tt.state, tt.plan))
+
+			if !reflect.DeepEqual(values, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, values)
+			}
+		})
+	}
+
+	t.Run("No secrets", func(t *testing.T) {
+		if values := encryptedValues(nil, true); len(values) != 0 {
+			t.Errorf("Expected no values, got %v", values)
+		}
+	})
+}
+
+// TestValidateNotificationSecrets tests that config_wo only accepts the
+// encrypted keys of the notification type
+func TestValidateNotificationSecrets(t *testing.T) {
+	secrets := func(keys ...string) types.Map {
+		values := make(map[string]attr.Value, len(keys))
+		for _, key := range keys {
+			values[key] = types.StringValue("secret")
+		}
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name        string
+		configType  types.String
+		secrets     types.Map
+		expectError bool
+	}{
+		{
+			name:        "Encrypted HTTP keys",
+			configType:  types.StringValue("http-notification-v2"),
+			secrets:     secrets("basic_auth", "api_secret"),
+			expectError: false,
+		},
+		{
+			name:        "Plain HTTP key",
+			configType:  types.StringValue("http-notification-v2"),
+			secrets:     secrets("basic_auth", "url"),
+			expectError: true,
+		},
+		{
+			name:        "Type without encrypted keys",
+			configType:  types.StringValue("slack-notification-v1"),
+			secrets:     secrets("webhook_url"),
+			expectError: true,
+		},
+		{
+			name:        "Unknown type",
+			configType:  types.StringUnknown(),
+			secrets:     secrets("url"),
+			expectError: false,
+		},
+		{
+			name:        "No secrets",
+			configType:  types.StringValue("email-notification-v1"),
+			secrets:     types.MapNull(types.StringType),
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateNotificationSecrets(tt.configType, tt.secrets, &diags)
+
+			if tt.expectError && !diags.HasError() {
+				t.Errorf("Expected error but got none")
+			}
+
+			if !tt.expectError && diags.HasError() {
+				t.Errorf("Unexpected error: %v", diags)
+			}
+		})
+	}
+}
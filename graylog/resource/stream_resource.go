@@ -0,0 +1,561 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &streamResource{}
+	_ resource.ResourceWithConfigure   = &streamResource{}
+	_ resource.ResourceWithImportState = &streamResource{}
+)
+
+// NewStreamResource is a helper function to simplify the provider implementation.
+func NewStreamResource() resource.Resource {
+	return &streamResource{}
+}
+
+// streamResource is the resource implementation.
+type streamResource struct {
+	client *client.Client
+}
+
+// streamResourceModel maps the resource schema data.
+type streamResourceModel struct {
+	ID                             types.String      `tfsdk:"id"`
+	Title                          types.String      `tfsdk:"title"`
+	Description                    types.String      `tfsdk:"description"`
+	IndexSetID                     types.String      `tfsdk:"index_set_id"`
+	MatchingType                   types.String      `tfsdk:"matching_type"`
+	RemoveMatchesFromDefaultStream types.Bool        `tfsdk:"remove_matches_from_default_stream"`
+	Disabled                       types.Bool        `tfsdk:"disabled"`
+	IsDefault                      types.Bool        `tfsdk:"is_default"`
+	Rules                          []streamRuleModel `tfsdk:"rule"`
+	Timeouts                       timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// streamRuleModel maps a nested stream rule block.
+type streamRuleModel struct {
+	ID          types.String `tfsdk:"id"`
+	Field       types.String `tfsdk:"field"`
+	Type        types.String `tfsdk:"type"`
+	Value       types.String `tfsdk:"value"`
+	Inverted    types.Bool   `tfsdk:"inverted"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Metadata returns the resource type name.
+func (r *streamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+// Schema defines the schema for the resource.
+func (r *streamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Graylog stream and its rules. The rules of the stream are only managed when at least one rule block is configured, in which case rules created outside of Terraform are removed. Removing all rule blocks leaves the rules of the stream in place. Do not combine nested rule blocks with graylog_stream_rule resources targeting the same stream.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the stream.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the stream.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the stream.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"index_set_id": schema.StringAttribute{
+				Description: "The ID of the index set messages routed into this stream are written to.",
+				Required:    true,
+			},
+			"matching_type": schema.StringAttribute{
+				Description: "Whether a message must match all rules ('AND') or at least one rule ('OR') to be routed into the stream.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("AND"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("AND", "OR"),
+				},
+			},
+			"remove_matches_from_default_stream": schema.BoolAttribute{
+				Description: "Whether messages matching this stream are removed from the default stream.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the stream is paused.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default stream.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "A rule messages are matched against to be routed into the stream. When set, the rule blocks are authoritative for all rules of the stream, conflicting with graylog_stream_rule.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the stream rule.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"field": schema.StringAttribute{
+							Description: "The message field the rule is evaluated against.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The rule type (exact, regex, greater, smaller, presence, contains, always_match or match_input).",
+							Required:    true,
+							Validators: []validator.String{
+								streamRuleTypeValidator(),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value the field is compared to.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+						},
+						"inverted": schema.BoolAttribute{
+							Description: "Whether the rule result is negated.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the stream rule.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *streamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *streamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan streamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build rules from the nested blocks
+	rules := []client.CreateStreamRuleRequest{}
+	for _, rule := range plan.Rules {
+		ruleReq, err := streamRuleRequestFromModel(rule)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Stream Rule",
+				err.Error(),
+			)
+			return
+		}
+		rules = append(rules, *ruleReq)
+	}
+
+	// Build the create request
+	createReq := &client.CreateStreamRequest{
+		Title:                          plan.Title.ValueString(),
+		Description:                    plan.Description.ValueString(),
+		Rules:                          rules,
+		MatchingType:                   plan.MatchingType.ValueString(),
+		RemoveMatchesFromDefaultStream: plan.RemoveMatchesFromDefaultStream.ValueBool(),
+		IndexSetID:                     plan.IndexSetID.ValueString(),
+	}
+
+	// Create the stream
+	stream, err := r.client.CreateStream(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Stream",
+			"Could not create stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Graylog creates streams paused, start it unless requested otherwise
+	if !plan.Disabled.ValueBool() {
+		if err := r.client.ResumeStream(ctx, stream.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resuming Stream",
+				"Could not resume stream ID "+stream.ID+": "+err.Error(),
+			)
+			return
+		}
+		stream.Disabled = false
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(stream.ID)
+	r.mapStreamToModel(stream, &plan)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state streamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get stream from API
+	stream, err := r.client.GetStream(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Stream",
+			"Could not read stream ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapStreamToModel(stream, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *streamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state streamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	streamID := plan.ID.ValueString()
+
+	// Build the update request
+	updateReq := &client.UpdateStreamRequest{
+		Title:                          plan.Title.ValueString(),
+		Description:                    plan.Description.ValueString(),
+		MatchingType:                   plan.MatchingType.ValueString(),
+		RemoveMatchesFromDefaultStream: plan.RemoveMatchesFromDefaultStream.ValueBool(),
+		IndexSetID:                     plan.IndexSetID.ValueString(),
+	}
+
+	// Update the stream
+	if _, err := r.client.UpdateStream(ctx, streamID, updateReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Stream",
+			"Could not update stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Without rule blocks the rules of the stream are left untouched, e.g. to
+	// graylog_stream_rule. Once configured, the blocks are authoritative for
+	// all rules of the stream.
+	if len(plan.Rules) == 0 {
+		plan.Rules = nil
+	} else {
+		current, err := r.client.GetStream(ctx, streamID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Stream",
+				"Could not read stream ID "+streamID+": "+err.Error(),
+			)
+			return
+		}
+
+		ruleIDs := make([]string, 0, len(current.Rules))
+		for _, rule := range orderStreamRules(state.Rules, current.Rules) {
+			ruleIDs = append(ruleIDs, rule.ID)
+		}
+
+		// Reconcile rules positionally so existing rules keep their IDs
+		for i, rule := range plan.Rules {
+			ruleReq, err := streamRuleRequestFromModel(rule)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Invalid Stream Rule",
+					err.Error(),
+				)
+				return
+			}
+
+			if i < len(ruleIDs) {
+				_, err = r.client.UpdateStreamRule(ctx, streamID, ruleIDs[i], ruleReq)
+			} else {
+				_, err = r.client.CreateStreamRule(ctx, streamID, ruleReq)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Stream Rules",
+					"Could not update rules of stream ID "+streamID+": "+err.Error(),
+				)
+				return
+			}
+		}
+		for i := len(plan.Rules); i < len(ruleIDs); i++ {
+			if err := r.client.DeleteStreamRule(ctx, streamID, ruleIDs[i]); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Stream Rules",
+					"Could not delete rule of stream ID "+streamID+": "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	// Pause or resume the stream if requested
+	if !plan.Disabled.Equal(state.Disabled) {
+		var err error
+		if plan.Disabled.ValueBool() {
+			err = r.client.PauseStream(ctx, streamID)
+		} else {
+			err = r.client.ResumeStream(ctx, streamID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Stream",
+				"Could not change paused state of stream ID "+streamID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Fetch the stream to pick up rule IDs and the final state
+	stream, err := r.client.GetStream(ctx, streamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Stream",
+			"Could not read stream ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	r.mapStreamToModel(stream, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *streamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state streamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete stream via API
+	err := r.client.DeleteStream(ctx, state.ID.ValueString())
+	if err != nil {
+		// Already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Stream",
+			"Could not delete stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *streamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapStreamToModel copies the API representation of a stream into the model.
+// Rules are only mapped when rule blocks are tracked in the model, leaving them
+// to graylog_stream_rule otherwise. Imported streams adopt their rules once
+// rule blocks are configured.
+func (r *streamResource) mapStreamToModel(stream *client.Stream, model *streamResourceModel) {
+	model.Title = types.StringValue(stream.Title)
+	model.Description = types.StringValue(stream.Description)
+	model.IndexSetID = types.StringValue(stream.IndexSetID)
+	model.MatchingType = types.StringValue(stream.MatchingType)
+	model.RemoveMatchesFromDefaultStream = types.BoolValue(stream.RemoveMatchesFromDefaultStream)
+	model.Disabled = types.BoolValue(stream.Disabled)
+	model.IsDefault = types.BoolValue(stream.IsDefault)
+
+	if len(model.Rules) == 0 {
+		return
+	}
+
+	rules := make([]streamRuleModel, 0, len(stream.Rules))
+	for _, rule := range orderStreamRules(model.Rules, stream.Rules) {
+		typeName, ok := client.StreamRuleTypeName(rule.Type)
+		if !ok {
+			typeName = fmt.Sprintf("%d", rule.Type)
+		}
+		rules = append(rules, streamRuleModel{
+			ID:          types.StringValue(rule.ID),
+			Field:       types.StringValue(rule.Field),
+			Type:        types.StringValue(typeName),
+			Value:       types.StringValue(rule.Value),
+			Inverted:    types.BoolValue(rule.Inverted),
+			Description: types.StringValue(rule.Description),
+		})
+	}
+	model.Rules = rules
+}
+
+// orderStreamRules sorts the rules returned by Graylog into the order they are
+// tracked in Terraform. Rules without a known ID are matched by content, rules
+// only known to Graylog are appended at the end.
+func orderStreamRules(tracked []streamRuleModel, apiRules []client.StreamRule) []client.StreamRule {
+	remaining := make([]client.StreamRule, len(apiRules))
+	copy(remaining, apiRules)
+
+	take := func(match func(client.StreamRule) bool) (client.StreamRule, bool) {
+		for i, rule := range remaining {
+			if match(rule) {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				return rule, true
+			}
+		}
+		return client.StreamRule{}, false
+	}
+
+	ordered := make([]client.StreamRule, 0, len(apiRules))
+	for _, model := range tracked {
+		var rule client.StreamRule
+		var ok bool
+		if !model.ID.IsNull() && !model.ID.IsUnknown() {
+			rule, ok = take(func(r client.StreamRule) bool {
+				return r.ID == model.ID.ValueString()
+			})
+		} else {
+			ruleType := client.StreamRuleTypeNames[model.Type.ValueString()]
+			rule, ok = take(func(r client.StreamRule) bool {
+				return r.Field == model.Field.ValueString() &&
+					r.Type == ruleType &&
+					r.Value == model.Value.ValueString() &&
+					r.Inverted == model.Inverted.ValueBool()
+			})
+		}
+		if ok {
+			ordered = append(ordered, rule)
+		}
+	}
+
+	return append(ordered, remaining...)
+}
+
+// streamRuleRequestFromModel converts a rule block into an API request.
+func streamRuleRequestFromModel(rule streamRuleModel) (*client.CreateStreamRuleRequest, error) {
+	ruleType, ok := client.StreamRuleTypeNames[rule.Type.ValueString()]
+	if !ok {
+		return nil, fmt.Errorf("unknown stream rule type %q", rule.Type.ValueString())
+	}
+
+	return &client.CreateStreamRuleRequest{
+		Field:       rule.Field.ValueString(),
+		Type:        ruleType,
+		Value:       rule.Value.ValueString(),
+		Inverted:    rule.Inverted.ValueBool(),
+		Description: rule.Description.ValueString(),
+	}, nil
+}
@@ -0,0 +1,417 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-graylog/graylog/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &streamRuleResource{}
+	_ resource.ResourceWithConfigure      = &streamRuleResource{}
+	_ resource.ResourceWithImportState    = &streamRuleResource{}
+	_ resource.ResourceWithValidateConfig = &streamRuleResource{}
+)
+
+// NewStreamRuleResource is a helper function to simplify the provider implementation.
+func NewStreamRuleResource() resource.Resource {
+	return &streamRuleResource{}
+}
+
+// streamRuleResource is the resource implementation.
+type streamRuleResource struct {
+	client *client.Client
+}
+
+// streamRuleResourceModel maps the resource schema data.
+type streamRuleResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	StreamID    types.String   `tfsdk:"stream_id"`
+	RuleID      types.String   `tfsdk:"rule_id"`
+	Field       types.String   `tfsdk:"field"`
+	Type        types.String   `tfsdk:"type"`
+	Value       types.String   `tfsdk:"value"`
+	Inverted    types.Bool     `tfsdk:"inverted"`
+	Description types.String   `tfsdk:"description"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *streamRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *streamRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single rule of a Graylog stream. Use this resource for streams whose rules are not managed through graylog_stream rule blocks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the stream rule in the form '<stream_id>/<rule_id>'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stream_id": schema.StringAttribute{
+				Description: "The ID of the stream the rule belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_id": schema.StringAttribute{
+				Description: "The unique identifier of the stream rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field": schema.StringAttribute{
+				Description: "The message field the rule is evaluated against.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The rule type (exact, regex, greater, smaller, presence, contains, always_match or match_input).",
+				Required:    true,
+				Validators: []validator.String{
+					streamRuleTypeValidator(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value the field is compared to. For 'match_input' rules this is the input ID.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"inverted": schema.BoolAttribute{
+				Description: "Whether the rule result is negated.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the stream rule.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *streamRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the rule value fits the selected rule type.
+func (r *streamRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config streamRuleResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() || config.Type.IsNull() || config.Value.IsUnknown() {
+		return
+	}
+
+	switch config.Type.ValueString() {
+	case "exact", "regex", "contains", "match_input":
+		if config.Value.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Missing Stream Rule Value",
+				fmt.Sprintf("Stream rules of type %q require a non-empty value.", config.Type.ValueString()),
+			)
+		}
+	case "greater", "smaller":
+		if _, err := strconv.ParseFloat(config.Value.ValueString(), 64); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid Stream Rule Value",
+				fmt.Sprintf("Stream rules of type %q require a numeric value, got %q.", config.Type.ValueString(), config.Value.ValueString()),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *streamRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan streamRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ruleReq, err := streamRuleRequestFromModel(streamRuleModel{
+		Field:       plan.Field,
+		Type:        plan.Type,
+		Value:       plan.Value,
+		Inverted:    plan.Inverted,
+		Description: plan.Description,
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Stream Rule",
+			err.Error(),
+		)
+		return
+	}
+
+	// Create the stream rule
+	rule, err := r.client.CreateStreamRule(ctx, plan.StreamID.ValueString(), ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Stream Rule",
+			"Could not create stream rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.RuleID = types.StringValue(rule.ID)
+	plan.ID = types.StringValue(plan.StreamID.ValueString() + "/" + rule.ID)
+	mapStreamRuleToModel(rule, &plan)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *streamRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state streamRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Look the stream up, a deleted stream is not an error
+	stream, err := r.findStream(ctx, state.StreamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Stream Rule",
+			"Could not read stream ID "+state.StreamID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if stream == nil {
+		resp.Diagnostics.AddWarning(
+			"Stream Not Found",
+			"Stream ID "+state.StreamID.ValueString()+" no longer exists, removing stream rule "+state.RuleID.ValueString()+" from state.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var rule *client.StreamRule
+	for i := range stream.Rules {
+		if stream.Rules[i].ID == state.RuleID.ValueString() {
+			rule = &stream.Rules[i]
+			break
+		}
+	}
+	if rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.ID = types.StringValue(state.StreamID.ValueString() + "/" + rule.ID)
+	mapStreamRuleToModel(rule, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *streamRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan streamRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ruleReq, err := streamRuleRequestFromModel(streamRuleModel{
+		Field:       plan.Field,
+		Type:        plan.Type,
+		Value:       plan.Value,
+		Inverted:    plan.Inverted,
+		Description: plan.Description,
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Stream Rule",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update the stream rule
+	rule, err := r.client.UpdateStreamRule(ctx, plan.StreamID.ValueString(), plan.RuleID.ValueString(), ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Stream Rule",
+			"Could not update stream rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	mapStreamRuleToModel(rule, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *streamRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state streamRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete stream rule via API
+	err := r.client.DeleteStreamRule(ctx, state.StreamID.ValueString(), state.RuleID.ValueString())
+	if err != nil {
+		// Already deleted outside of Terraform, deleting the stream also removes its rules
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Stream Rule",
+			"Could not delete stream rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from a '<stream_id>/<rule_id>' identifier.
+func (r *streamRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <stream_id>/<rule_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("stream_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_id"), parts[1])...)
+}
+
+// findStream returns the stream with the given ID or nil if it does not exist.
+func (r *streamRuleResource) findStream(ctx context.Context, id string) (*client.Stream, error) {
+	stream, err := r.client.GetStream(ctx, id)
+	if client.IsNotFound(err) {
+		return nil, nil
+	}
+	return stream, err
+}
+
+// mapStreamRuleToModel copies the API representation of a stream rule into the model.
+func mapStreamRuleToModel(rule *client.StreamRule, model *streamRuleResourceModel) {
+	typeName, ok := client.StreamRuleTypeName(rule.Type)
+	if !ok {
+		typeName = strconv.Itoa(rule.Type)
+	}
+
+	model.RuleID = types.StringValue(rule.ID)
+	model.Field = types.StringValue(rule.Field)
+	model.Type = types.StringValue(typeName)
+	model.Value = types.StringValue(rule.Value)
+	model.Inverted = types.BoolValue(rule.Inverted)
+	model.Description = types.StringValue(rule.Description)
+}
+
+// streamRuleTypeValidator validates stream rule type names at plan time.
+func streamRuleTypeValidator() validator.String {
+	names := make([]string, 0, len(client.StreamRuleTypeNames))
+	for name := range client.StreamRuleTypeNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return stringvalidator.OneOf(names...)
+}
@@ -0,0 +1,301 @@
+package rulelang
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind identifies the lexical class of a token
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenKeyword
+	tokenString
+	tokenChar
+	tokenInteger
+	tokenFloat
+	tokenMessageRef
+	tokenOperator
+)
+
+// keywords of the rule language, matched case-insensitively
+var keywords = map[string]bool{
+	"rule":  true,
+	"when":  true,
+	"then":  true,
+	"end":   true,
+	"let":   true,
+	"and":   true,
+	"or":    true,
+	"not":   true,
+	"true":  true,
+	"false": true,
+}
+
+// operators ordered so that longer operators are matched first
+var operators = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"!", "<", ">", "=", "+", "-", "*", "/", "%",
+	"(", ")", "[", "]", "{", "}", ",", ";", ":", ".",
+}
+
+// token is a single lexical element of a rule source
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+}
+
+// describe returns a description of the token suitable for error messages
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of input"
+	case tokenString:
+		return "string " + t.text
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+// is reports whether the token is the given keyword or operator
+func (t token) is(text string) bool {
+	switch t.kind {
+	case tokenKeyword:
+		return strings.EqualFold(t.text, text)
+	case tokenOperator:
+		return t.text == text
+	}
+	return false
+}
+
+// lexer splits a rule source into tokens
+type lexer struct {
+	src    []rune
+	pos    int
+	line   int
+	column int
+	errors []Error
+}
+
+// tokenize returns all tokens of the source followed by an EOF token
+func tokenize(source string) ([]token, []Error) {
+	l := &lexer{src: []rune(source), line: 1, column: 1}
+
+	var tokens []token
+	for {
+		tok := l.next()
+		tokens = append(tokens, tok)
+		if tok.kind == tokenEOF {
+			return tokens, l.errors
+		}
+	}
+}
+
+func (l *lexer) peek(offset int) rune {
+	if l.pos+offset >= len(l.src) {
+		return 0
+	}
+	return l.src[l.pos+offset]
+}
+
+func (l *lexer) advance() rune {
+	r := l.src[l.pos]
+	l.pos++
+	if r == '\n' {
+		l.line++
+		l.column = 1
+	} else {
+		l.column++
+	}
+	return r
+}
+
+func (l *lexer) errorf(line, column int, format string, args ...interface{}) {
+	l.errors = append(l.errors, Error{Line: line, Column: column, Message: fmt.Sprintf(format, args...)})
+}
+
+// skipSpace skips whitespace and comments
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.src) {
+		r := l.peek(0)
+		switch {
+		case unicode.IsSpace(r):
+			l.advance()
+		case r == '/' && l.peek(1) == '/':
+			for l.pos < len(l.src) && l.peek(0) != '\n' {
+				l.advance()
+			}
+		case r == '/' && l.peek(1) == '*':
+			line, column := l.line, l.column
+			l.advance()
+			l.advance()
+			for {
+				if l.pos >= len(l.src) {
+					l.errorf(line, column, "unterminated block comment")
+					return
+				}
+				if l.peek(0) == '*' && l.peek(1) == '/' {
+					l.advance()
+					l.advance()
+					break
+				}
+				l.advance()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) next() token {
+	l.skipSpace()
+
+	line, column := l.line, l.column
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, line: line, column: column}
+	}
+
+	r := l.peek(0)
+	switch {
+	case r == '"' || r == '\'':
+		return l.quoted(r, line, column)
+	case r == '`':
+		return l.quotedIdent(line, column)
+	case r == '$':
+		return l.messageRef(line, column)
+	case unicode.IsDigit(r):
+		return l.number(line, column)
+	case r == '_' || unicode.IsLetter(r):
+		start := l.pos
+		for l.pos < len(l.src) && (l.peek(0) == '_' || unicode.IsLetter(l.peek(0)) || unicode.IsDigit(l.peek(0))) {
+			l.advance()
+		}
+		text := string(l.src[start:l.pos])
+		if keywords[strings.ToLower(text)] {
+			return token{kind: tokenKeyword, text: text, line: line, column: column}
+		}
+		return token{kind: tokenIdent, text: text, line: line, column: column}
+	}
+
+	for _, op := range operators {
+		if strings.HasPrefix(string(l.src[l.pos:min(l.pos+len(op), len(l.src))]), op) {
+			for range op {
+				l.advance()
+			}
+			return token{kind: tokenOperator, text: op, line: line, column: column}
+		}
+	}
+
+	l.advance()
+	l.errorf(line, column, "unexpected character '%c'", r)
+	return l.next()
+}
+
+// quoted reads a double quoted string or a single quoted character literal
+func (l *lexer) quoted(quote rune, line, column int) token {
+	start := l.pos
+	l.advance()
+	for {
+		if l.pos >= len(l.src) || l.peek(0) == '\n' {
+			l.errorf(line, column, "unterminated string literal")
+			return token{kind: tokenString, text: string(l.src[start:l.pos]), line: line, column: column}
+		}
+		r := l.advance()
+		if r == '\\' {
+			if l.pos < len(l.src) {
+				l.advance()
+			}
+			continue
+		}
+		if r == quote {
+			break
+		}
+	}
+
+	kind := tokenString
+	if quote == '\'' {
+		kind = tokenChar
+	}
+	return token{kind: kind, text: string(l.src[start:l.pos]), line: line, column: column}
+}
+
+// quotedIdent reads a backtick quoted identifier
+func (l *lexer) quotedIdent(line, column int) token {
+	start := l.pos
+	l.advance()
+	for {
+		if l.pos >= len(l.src) || l.peek(0) == '\n' {
+			l.errorf(line, column, "unterminated quoted identifier")
+			break
+		}
+		if l.advance() == '`' {
+			break
+		}
+	}
+	return token{kind: tokenIdent, text: string(l.src[start:l.pos]), line: line, column: column}
+}
+
+// messageRef reads the '$message' reference
+func (l *lexer) messageRef(line, column int) token {
+	start := l.pos
+	l.advance()
+	for l.pos < len(l.src) && (l.peek(0) == '_' || unicode.IsLetter(l.peek(0)) || unicode.IsDigit(l.peek(0))) {
+		l.advance()
+	}
+	text := string(l.src[start:l.pos])
+	if text != "$message" {
+		l.errorf(line, column, "unknown reference '%s', only '$message' is supported", text)
+	}
+	return token{kind: tokenMessageRef, text: text, line: line, column: column}
+}
+
+// number reads an integer or floating point literal
+func (l *lexer) number(line, column int) token {
+	start := l.pos
+	kind := tokenInteger
+
+	if l.peek(0) == '0' && (l.peek(1) == 'x' || l.peek(1) == 'X') {
+		l.advance()
+		l.advance()
+		for l.pos < len(l.src) && strings.ContainsRune("0123456789abcdefABCDEF", l.peek(0)) {
+			l.advance()
+		}
+		return token{kind: kind, text: string(l.src[start:l.pos]), line: line, column: column}
+	}
+
+	for l.pos < len(l.src) && unicode.IsDigit(l.peek(0)) {
+		l.advance()
+	}
+	if l.peek(0) == '.' && unicode.IsDigit(l.peek(1)) {
+		kind = tokenFloat
+		l.advance()
+		for l.pos < len(l.src) && unicode.IsDigit(l.peek(0)) {
+			l.advance()
+		}
+	}
+	if l.peek(0) == 'e' || l.peek(0) == 'E' {
+		offset := 1
+		if l.peek(1) == '+' || l.peek(1) == '-' {
+			offset = 2
+		}
+		if unicode.IsDigit(l.peek(offset)) {
+			kind = tokenFloat
+			for i := 0; i < offset; i++ {
+				l.advance()
+			}
+			for l.pos < len(l.src) && unicode.IsDigit(l.peek(0)) {
+				l.advance()
+			}
+		}
+	}
+	if l.peek(0) == 'L' || l.peek(0) == 'l' || l.peek(0) == 'd' || l.peek(0) == 'D' || l.peek(0) == 'f' || l.peek(0) == 'F' {
+		l.advance()
+	}
+
+	return token{kind: kind, text: string(l.src[start:l.pos]), line: line, column: column}
+}
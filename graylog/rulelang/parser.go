@@ -0,0 +1,390 @@
+// Package rulelang implements an offline parser for the Graylog pipeline rule
+// language. It checks the syntax of rule sources without contacting a Graylog
+// server; function names and argument types are not resolved.
+package rulelang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error describes a syntax error in a rule source. Line and Column are 1-based.
+type Error struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface
+func (e Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// Rule is the result of parsing a rule source
+type Rule struct {
+	Name        string
+	Description string
+}
+
+// Parse parses a single rule declaration of the form
+//
+//	rule "name" when <condition> then <statements> end
+//
+// and returns the rule together with all syntax errors found. The rule is
+// nil when the rule header could not be parsed.
+func Parse(source string) (*Rule, []Error) {
+	tokens, errs := tokenize(source)
+	p := &parser{tokens: tokens, errors: errs}
+	rule := p.parseRule()
+
+	return rule, p.errors
+}
+
+// Validate returns all syntax errors of a rule source
+func Validate(source string) []Error {
+	_, errs := Parse(source)
+	return errs
+}
+
+// parseAbort is used to unwind the parser after a syntax error
+type parseAbort struct{}
+
+// parser is a recursive descent parser over the token stream
+type parser struct {
+	tokens []token
+	pos    int
+	errors []Error
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) advance() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is one of the given keywords or operators
+func (p *parser) accept(texts ...string) bool {
+	for _, text := range texts {
+		if p.peek().is(text) {
+			p.advance()
+			return true
+		}
+	}
+	return false
+}
+
+// acceptIdent consumes the next token if it is the given contextual keyword
+func (p *parser) acceptIdent(text string) bool {
+	if tok := p.peek(); tok.kind == tokenIdent && strings.EqualFold(tok.text, text) {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+// fail records a syntax error at the given token and aborts the current production
+func (p *parser) fail(tok token, format string, args ...interface{}) {
+	p.errors = append(p.errors, Error{Line: tok.line, Column: tok.column, Message: fmt.Sprintf(format, args...)})
+	panic(parseAbort{})
+}
+
+// expect consumes the given keyword or operator or fails
+func (p *parser) expect(text, context string) token {
+	tok := p.peek()
+	if !tok.is(text) {
+		p.fail(tok, "expected '%s' %s but found %s", text, context, tok.describe())
+	}
+	return p.advance()
+}
+
+// recoverAbort turns a parse abort back into normal control flow
+func (p *parser) recoverAbort(aborted *bool) {
+	if r := recover(); r != nil {
+		if _, ok := r.(parseAbort); !ok {
+			panic(r)
+		}
+		*aborted = true
+	}
+}
+
+func (p *parser) parseRule() (rule *Rule) {
+	var aborted bool
+	defer p.recoverAbort(&aborted)
+
+	p.expect("rule", "at the start of the rule declaration")
+
+	nameTok := p.peek()
+	if nameTok.kind != tokenString {
+		p.fail(nameTok, "expected the rule name as a string but found %s", nameTok.describe())
+	}
+	p.advance()
+	rule = &Rule{Name: unquote(nameTok.text)}
+
+	if p.acceptIdent("description") {
+		descTok := p.peek()
+		if descTok.kind != tokenString {
+			p.fail(descTok, "expected the rule description as a string but found %s", descTok.describe())
+		}
+		p.advance()
+		rule.Description = unquote(descTok.text)
+	}
+
+	p.acceptIdent("during")
+	p.expect("when", "after the rule name")
+	p.parseExpression()
+	p.expect("then", "after the rule condition")
+
+	for !p.peek().is("end") {
+		if p.peek().kind == tokenEOF {
+			p.fail(p.peek(), "expected 'end' to close the rule but found %s", p.peek().describe())
+		}
+		p.parseStatementRecovering()
+	}
+	p.expect("end", "to close the rule")
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		p.fail(tok, "unexpected %s after the end of the rule", tok.describe())
+	}
+
+	return rule
+}
+
+// parseStatementRecovering parses a statement and on error skips ahead to the
+// next statement so that further errors can be reported
+func (p *parser) parseStatementRecovering() {
+	var aborted bool
+	func() {
+		defer p.recoverAbort(&aborted)
+		p.parseStatement()
+	}()
+
+	if aborted {
+		for {
+			tok := p.peek()
+			if tok.kind == tokenEOF || tok.is("end") {
+				return
+			}
+			p.advance()
+			if tok.is(";") {
+				return
+			}
+		}
+	}
+}
+
+func (p *parser) parseStatement() {
+	if p.accept(";") {
+		return
+	}
+
+	if p.accept("let") {
+		nameTok := p.peek()
+		if nameTok.kind != tokenIdent {
+			p.fail(nameTok, "expected a variable name after 'let' but found %s", nameTok.describe())
+		}
+		p.advance()
+		p.expect("=", "after the variable name")
+		p.parseExpression()
+		p.expect(";", "after the variable assignment")
+		return
+	}
+
+	p.parseExpression()
+	p.expect(";", "after the statement")
+}
+
+func (p *parser) parseExpression() {
+	p.parseOr()
+}
+
+func (p *parser) parseOr() {
+	p.parseAnd()
+	for p.accept("or", "||") {
+		p.parseAnd()
+	}
+}
+
+func (p *parser) parseAnd() {
+	p.parseNot()
+	for p.accept("and", "&&") {
+		p.parseNot()
+	}
+}
+
+func (p *parser) parseNot() {
+	if p.accept("not", "!") {
+		p.parseNot()
+		return
+	}
+	p.parseEquality()
+}
+
+func (p *parser) parseEquality() {
+	p.parseComparison()
+	for p.accept("==", "!=") {
+		p.parseComparison()
+	}
+}
+
+func (p *parser) parseComparison() {
+	p.parseAdditive()
+	for p.accept("<=", ">=", "<", ">") {
+		p.parseAdditive()
+	}
+}
+
+func (p *parser) parseAdditive() {
+	p.parseMultiplicative()
+	for p.accept("+", "-") {
+		p.parseMultiplicative()
+	}
+}
+
+func (p *parser) parseMultiplicative() {
+	p.parseUnary()
+	for p.accept("*", "/", "%") {
+		p.parseUnary()
+	}
+}
+
+func (p *parser) parseUnary() {
+	if p.accept("+", "-") {
+		p.parseUnary()
+		return
+	}
+	p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() {
+	p.parsePrimary()
+	for {
+		switch {
+		case p.accept("."):
+			tok := p.peek()
+			if tok.kind != tokenIdent && tok.kind != tokenKeyword {
+				p.fail(tok, "expected a field name after '.' but found %s", tok.describe())
+			}
+			p.advance()
+		case p.accept("["):
+			p.parseExpression()
+			p.expect("]", "to close the index expression")
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) parsePrimary() {
+	tok := p.peek()
+
+	switch tok.kind {
+	case tokenString, tokenChar, tokenMessageRef:
+		p.advance()
+		return
+	case tokenInteger, tokenFloat:
+		p.advance()
+		return
+	case tokenKeyword:
+		if tok.is("true") || tok.is("false") {
+			p.advance()
+			return
+		}
+	case tokenIdent:
+		p.advance()
+		if p.accept("(") {
+			p.parseArguments()
+		}
+		return
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			p.advance()
+			p.parseExpression()
+			p.expect(")", "to close the parenthesized expression")
+			return
+		case "[":
+			p.advance()
+			if !p.accept("]") {
+				p.parseExpression()
+				for p.accept(",") {
+					p.parseExpression()
+				}
+				p.expect("]", "to close the array literal")
+			}
+			return
+		case "{":
+			p.advance()
+			if !p.accept("}") {
+				p.parseMapEntry()
+				for p.accept(",") {
+					p.parseMapEntry()
+				}
+				p.expect("}", "to close the map literal")
+			}
+			return
+		}
+	}
+
+	p.fail(tok, "expected an expression but found %s", tok.describe())
+}
+
+// parseArguments parses function call arguments after the opening parenthesis.
+// Arguments are either all positional or all named ('name: value' or 'name = value').
+func (p *parser) parseArguments() {
+	if p.accept(")") {
+		return
+	}
+
+	named := p.peek().kind == tokenIdent && (p.peekAt(1).is(":") || p.peekAt(1).is("="))
+	for {
+		if named {
+			nameTok := p.peek()
+			if nameTok.kind != tokenIdent {
+				p.fail(nameTok, "expected a named argument but found %s", nameTok.describe())
+			}
+			p.advance()
+			if !p.accept(":", "=") {
+				p.fail(p.peek(), "expected ':' after the argument name '%s' but found %s", nameTok.text, p.peek().describe())
+			}
+		}
+		p.parseExpression()
+
+		if !p.accept(",") {
+			break
+		}
+	}
+
+	p.expect(")", "to close the function call")
+}
+
+func (p *parser) parseMapEntry() {
+	keyTok := p.peek()
+	if keyTok.kind != tokenIdent && keyTok.kind != tokenString {
+		p.fail(keyTok, "expected a map key but found %s", keyTok.describe())
+	}
+	p.advance()
+	p.expect(":", "after the map key")
+	p.parseExpression()
+}
+
+// unquote strips the quotes of a string literal, tolerating invalid escapes
+func unquote(text string) string {
+	if value, err := strconv.Unquote(text); err == nil {
+		return value
+	}
+	return strings.Trim(text, `"`)
+}
@@ -0,0 +1,133 @@
+package rulelang
+
+import (
+	"testing"
+)
+
+// TestParseValidRules tests that well-formed rules parse without errors
+func TestParseValidRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		ruleName string
+	}{
+		{
+			name:     "Minimal rule",
+			source:   `rule "always" when true then end`,
+			ruleName: "always",
+		},
+		{
+			name: "Field comparison and function calls",
+			source: `rule "tag payments"
+when
+  has_field("application") && to_string($message.application) == "payments"
+then
+  set_field("team", "billing");
+  remove_field("debug");
+end`,
+			ruleName: "tag payments",
+		},
+		{
+			name: "Variables, named arguments and literals",
+			source: `// extract the user
+rule "parse user"
+when
+  not has_field("user") and $message.level <= 3
+then
+  /* regex with named arguments */
+  let m = regex(pattern: "user=(\\w+)", value: to_string($message.message));
+  set_field(field: "user", value: m["0"]);
+  set_fields({ count: 1 + 2 * 3, ratio: -0.5, list: [1, 2, 3] });
+end`,
+			ruleName: "parse user",
+		},
+		{
+			name:     "Keywords are case-insensitive",
+			source:   `RULE "upper" WHEN TRUE OR false THEN END`,
+			ruleName: "upper",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, errs := Parse(tt.source)
+
+			if len(errs) != 0 {
+				t.Fatalf("Unexpected errors: %v", errs)
+			}
+
+			if rule == nil || rule.Name != tt.ruleName {
+				t.Errorf("Expected rule name %q, got %+v", tt.ruleName, rule)
+			}
+		})
+	}
+}
+
+// TestParseInvalidRules tests that syntax errors are reported with their position
+func TestParseInvalidRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		errors []Error
+	}{
+		{
+			name:   "Missing rule keyword",
+			source: `"x" when true then end`,
+			errors: []Error{{Line: 1, Column: 1}},
+		},
+		{
+			name:   "Unquoted rule name",
+			source: `rule x when true then end`,
+			errors: []Error{{Line: 1, Column: 6}},
+		},
+		{
+			name:   "Missing then",
+			source: "rule \"x\"\nwhen true\nset_field(\"a\", 1);\nend",
+			errors: []Error{{Line: 3, Column: 1}},
+		},
+		{
+			name:   "Missing semicolons are reported per statement",
+			source: "rule \"x\"\nwhen true\nthen\n  set_field(\"a\", 1)\n  set_field(\"b\", 2);\n  drop_message()\nend",
+			errors: []Error{{Line: 5, Column: 3}, {Line: 7, Column: 1}},
+		},
+		{
+			name:   "Unterminated string",
+			source: "rule \"x\" when has_field(\"a) then end",
+			errors: []Error{{Line: 1, Column: 25}, {Line: 1, Column: 37}},
+		},
+		{
+			name:   "Missing end",
+			source: "rule \"x\" when true then set_field(\"a\", 1);",
+			errors: []Error{{Line: 1, Column: 43}},
+		},
+		{
+			name:   "Trailing content",
+			source: "rule \"x\" when true then end\nrule \"y\" when true then end",
+			errors: []Error{{Line: 2, Column: 1}},
+		},
+		{
+			name:   "Unknown reference",
+			source: "rule \"x\" when $msg.a == 1 then end",
+			errors: []Error{{Line: 1, Column: 15}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate(tt.source)
+
+			if len(errs) != len(tt.errors) {
+				t.Fatalf("Expected %d errors, got %d: %v", len(tt.errors), len(errs), errs)
+			}
+
+			for i, expected := range tt.errors {
+				if errs[i].Line != expected.Line || errs[i].Column != expected.Column {
+					t.Errorf("Expected error %d at line %d, column %d, got %v", i, expected.Line, expected.Column, errs[i])
+				}
+				if errs[i].Message == "" {
+					t.Errorf("Expected error %d to have a message", i)
+				}
+			}
+		})
+	}
+}